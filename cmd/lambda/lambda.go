@@ -2,16 +2,23 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"net"
+	"net/http"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/awslabs/aws-lambda-go-api-proxy/httpadapter"
+	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
 
 	"study-planner-api/internal/api"
 	"study-planner-api/internal/database"
 	handlerImpl "study-planner-api/internal/handler"
+	"study-planner-api/internal/maintenance"
+	"study-planner-api/internal/ratelimit"
 	_ "study-planner-api/internal/utils/env"
+	"study-planner-api/internal/utils/signature"
 )
 
 var httpLambda *httpadapter.HandlerAdapterV2
@@ -20,16 +27,43 @@ func init() {
 	log.Printf("Echo cold start")
 
 	database.Instance()
+	signature.MustLoadKey()
 
 	impl := api.NewStrictHandler(handlerImpl.NewHandler(), nil)
 
-	handler := api.NewEchoHandler()
+	// Instances do not share memory, so the rate limits are kept in the database.
+	// No proxy is trusted, so the IP address is always the source IP seen by API Gateway,
+	// and the entries the client puts in X-Forwarded-For are ignored.
+	handler := api.NewEchoHandler(ratelimit.NewDBStore(), echo.ExtractIPFromXFFHeader(
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	))
 	api.RegisterHandlers(handler, impl)
 
-	httpLambda = httpadapter.NewV2(handler)
+	// The adapter sets the source IP as the remote address without the port echo expects
+	httpLambda = httpadapter.NewV2(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.RemoteAddr = net.JoinHostPort(r.RemoteAddr, "0")
+		handler.ServeHTTP(w, r)
+	}))
 }
 
-func Handler(ctx context.Context, req events.APIGatewayV2HTTPRequest) (events.APIGatewayV2HTTPResponse, error) {
+// Events sent by the EventBridge schedule only need their source to be recognized
+type scheduledEvent struct {
+	Source string `json:"source"`
+}
+
+func Handler(ctx context.Context, rawEvent json.RawMessage) (any, error) {
+	var scheduled scheduledEvent
+	if err := json.Unmarshal(rawEvent, &scheduled); err == nil && scheduled.Source == "aws.events" {
+		return nil, maintenance.Run()
+	}
+
+	var req events.APIGatewayV2HTTPRequest
+	if err := json.Unmarshal(rawEvent, &req); err != nil {
+		return nil, err
+	}
+
 	return httpLambda.ProxyWithContext(ctx, req)
 }
 
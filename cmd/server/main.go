@@ -9,14 +9,21 @@ import (
 	"strconv"
 	"study-planner-api/internal/api"
 	handlerImpl "study-planner-api/internal/handler"
+	"study-planner-api/internal/maintenance"
+	"study-planner-api/internal/ratelimit"
 	_ "study-planner-api/internal/utils/env"
+	"study-planner-api/internal/utils/signature"
 	"syscall"
 	"time"
 
+	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
+// Buckets kept in memory by the rate limits, one per route and client
+const rateLimitMaxBuckets = 100_000
+
 func gracefulShutdown(apiServer *http.Server, done chan bool) {
 	// Create context that listens for the interrupt signal from the OS
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
@@ -39,6 +46,24 @@ func gracefulShutdown(apiServer *http.Server, done chan bool) {
 	done <- true
 }
 
+// Runs the clean up jobs periodically until the context is done
+func runMaintenance(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := maintenance.Run(); err != nil {
+			log.Error().Err(err).Msg("Maintenance failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func setupPrettyZeroLog() {
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stdout})
@@ -46,10 +71,12 @@ func setupPrettyZeroLog() {
 
 func main() {
 	setupPrettyZeroLog()
+	signature.MustLoadKey()
 
 	impl := api.NewStrictHandler(handlerImpl.NewHandler(), nil)
 
-	handler := api.NewEchoHandler()
+	// The server faces the clients directly, so forwarding headers are not trusted
+	handler := api.NewEchoHandler(ratelimit.NewMemoryStore(rateLimitMaxBuckets), echo.ExtractIPDirect())
 	api.RegisterHandlers(handler, impl)
 
 	port, _ := strconv.Atoi(os.Getenv("PORT"))
@@ -65,6 +92,10 @@ func main() {
 	done := make(chan bool, 1)
 	go gracefulShutdown(s, done)
 
+	maintenanceCtx, stopMaintenance := context.WithCancel(context.Background())
+	defer stopMaintenance()
+	go runMaintenance(maintenanceCtx, time.Hour)
+
 	log.Info().Msgf("Server starting on \x1b[33mhttp://localhost%s\x1b[0m", s.Addr)
 
 	err := s.ListenAndServe()
@@ -30,3 +30,20 @@ const lambda = new aws.lambda.Function("test_pulumi", {
   role: role.arn,
   handler: "bootstrap",
 });
+
+// Periodically invokes the lambda to run clean up jobs (e.g. purging the task trash)
+const maintenanceSchedule = new aws.cloudwatch.EventRule("maintenance_schedule", {
+  scheduleExpression: "rate(1 hour)",
+});
+
+new aws.cloudwatch.EventTarget("maintenance_target", {
+  rule: maintenanceSchedule.name,
+  arn: lambda.arn,
+});
+
+new aws.lambda.Permission("maintenance_permission", {
+  action: "lambda:InvokeFunction",
+  function: lambda.name,
+  principal: "events.amazonaws.com",
+  sourceArn: maintenanceSchedule.arn,
+});
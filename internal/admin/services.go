@@ -0,0 +1,271 @@
+// Administration of the users and usage of the whole system, restricted to admins.
+package admin
+
+import (
+	"errors"
+	"strings"
+	"study-planner-api/internal/audit"
+	"study-planner-api/internal/auth/role"
+	"study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/user"
+	"study-planner-api/internal/utils"
+	"study-planner-api/internal/utils/cursor"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrUserNotFound  = errors.New("user not found")
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrInvalidStatus = errors.New("invalid status")
+)
+
+type Status string
+
+const (
+	StatusActive          Status = "active"
+	StatusUnactivated     Status = "unactivated"
+	StatusDisabled        Status = "disabled"
+	StatusPendingDeletion Status = "pending_deletion"
+)
+
+// Conditions on the user table matching each status
+var statusConditions = map[Status]string{
+	StatusActive:          "user.is_activated AND user.disabled_at IS NULL AND user.deletion_scheduled_at IS NULL",
+	StatusUnactivated:     "NOT user.is_activated AND user.disabled_at IS NULL",
+	StatusDisabled:        "user.disabled_at IS NOT NULL",
+	StatusPendingDeletion: "user.deletion_scheduled_at IS NOT NULL",
+}
+
+// A user as seen by admins
+type User struct {
+	model.User
+	// Sessions which have not expired
+	ActiveSessions int64
+}
+
+type UserFilter struct {
+	// Part of the email or display name, case insensitive
+	Query  *string
+	Role   *role.Role
+	Status *Status
+}
+
+var usersKeyset = cursor.Keyset{
+	Name:       "id:desc",
+	Expression: "user.id",
+	IDColumn:   "user.id",
+	Desc:       true,
+}
+
+// Counts the sessions of the users which have not expired
+func countActiveSessions(userIDs []int32) (map[int32]int64, error) {
+	var rows []struct {
+		UserID int32
+		Count  int64
+	}
+	result := database.Instance().
+		Model(&model.UserSession{}).
+		Select("user_id, COUNT(*) AS count").
+		Where("user_id IN ? AND (expires_at IS NULL OR expires_at > ?)", userIDs, time.Now()).
+		Group("user_id").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	counts := make(map[int32]int64, len(rows))
+	for _, row := range rows {
+		counts[row.UserID] = row.Count
+	}
+
+	return counts, nil
+}
+
+func withActiveSessions(users []model.User) ([]User, error) {
+	userIDs := make([]int32, len(users))
+	for i, u := range users {
+		userIDs[i] = u.ID
+	}
+
+	counts, err := countActiveSessions(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]User, len(users))
+	for i, u := range users {
+		result[i] = User{User: u, ActiveSessions: counts[u.ID]}
+	}
+
+	return result, nil
+}
+
+// Escapes the wildcards of LIKE patterns, "\" being the escape character
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+// Searches the users matching the filter, the most recent first
+func SearchUsers(filter UserFilter, pagination *utils.Pagination) ([]User, error) {
+	var condition string
+	if filter.Status != nil {
+		var ok bool
+		condition, ok = statusConditions[*filter.Status]
+		if !ok {
+			return nil, ErrInvalidStatus
+		}
+	}
+
+	constructQuery := func(db *gorm.DB) *gorm.DB {
+		query := db.Model(&model.User{})
+
+		if filter.Query != nil && strings.TrimSpace(*filter.Query) != "" {
+			pattern := "%" + escapeLike(strings.TrimSpace(*filter.Query)) + "%"
+			query = query.Where(
+				`user.email LIKE ? ESCAPE '\' OR user.display_name LIKE ? ESCAPE '\'`,
+				pattern, pattern,
+			)
+		}
+		if filter.Role != nil {
+			query = query.Where("user.role = ?", filter.Role.String())
+		}
+		if condition != "" {
+			query = query.Where(condition)
+		}
+
+		return query
+	}
+
+	var users []model.User
+	err := cursor.Find(
+		constructQuery,
+		"user.*",
+		usersKeyset,
+		pagination,
+		&users,
+		func(u model.User) (any, int32) {
+			return u.ID, u.ID
+		},
+	)
+	if err != nil {
+		if errors.Is(err, cursor.ErrInvalidCursor) {
+			return nil, ErrInvalidCursor
+		}
+		return nil, err
+	}
+
+	return withActiveSessions(users)
+}
+
+func GetUser(userID int32) (User, error) {
+	var user model.User
+	result := database.Instance().
+		Where("id = ?", userID).
+		First(&user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return User{}, ErrUserNotFound
+		}
+		return User{}, result.Error
+	}
+
+	users, err := withActiveSessions([]model.User{user})
+	if err != nil {
+		return User{}, err
+	}
+
+	return users[0], nil
+}
+
+// Usage counts of the whole system
+type Stats struct {
+	Users struct {
+		Total           int64
+		Activated       int64
+		Disabled        int64
+		PendingDeletion int64
+		Admins          int64
+		// Created in the last 30 days
+		Recent int64
+	}
+	// Sessions which have not expired
+	ActiveSessions int64
+	// Users with a session used in the last 30 days
+	ActiveUsers int64
+	// Tasks in the trash are not counted
+	Tasks         int64
+	FocusSessions int64
+	// In seconds
+	FocusTime int64
+}
+
+const statsRecentPeriod = time.Hour * 24 * 30
+
+func GetStats() (Stats, error) {
+	var stats Stats
+	now := time.Now()
+	since := now.Add(-statsRecentPeriod)
+	db := database.Instance()
+
+	result := db.
+		Model(&model.User{}).
+		Select(
+			`COUNT(*) AS total,
+			COALESCE(SUM(is_activated), 0) AS activated,
+			COUNT(disabled_at) AS disabled,
+			COUNT(deletion_scheduled_at) AS pending_deletion,
+			COALESCE(SUM(role = ?), 0) AS admins,
+			COALESCE(SUM(created_at >= ?), 0) AS recent`,
+			role.Admin.String(), since,
+		).
+		Scan(&stats.Users)
+	if result.Error != nil {
+		return Stats{}, result.Error
+	}
+
+	counts := []struct {
+		query *gorm.DB
+		dest  *int64
+	}{
+		{db.Model(&model.UserSession{}).Where("expires_at IS NULL OR expires_at > ?", now), &stats.ActiveSessions},
+		{db.Model(&model.UserSession{}).Where("last_used_at >= ?", since).Distinct("user_id"), &stats.ActiveUsers},
+		{db.Model(&model.Task{}), &stats.Tasks},
+		{db.Model(&model.FocusSession{}), &stats.FocusSessions},
+	}
+	for _, count := range counts {
+		err := count.query.Count(count.dest).Error
+		if err != nil {
+			return Stats{}, err
+		}
+	}
+
+	result = db.
+		Model(&model.FocusSession{}).
+		Select("COALESCE(SUM(focus_duration), 0)").
+		Scan(&stats.FocusTime)
+	if result.Error != nil {
+		return Stats{}, result.Error
+	}
+
+	return stats, nil
+}
+
+// Sends the activation email of the user again, on behalf of the admin "adminID"
+func ResendActivationEmail(userID int32, adminID int32, client audit.Client) error {
+	err := user.SendActivationEmail(userID)
+	if err != nil {
+		return err
+	}
+
+	return audit.Record(audit.Event{
+		UserID: &userID,
+		Type:   audit.EventActivationEmailResent,
+		Details: map[string]any{
+			"admin_id": adminID,
+		},
+		Client: &client,
+	})
+}
@@ -3,6 +3,8 @@ package api
 import (
 	"context"
 	"net/http"
+	"study-planner-api/internal/auth"
+	"study-planner-api/internal/auth/role"
 
 	"github.com/rs/zerolog/log"
 )
@@ -12,16 +14,21 @@ type contextKey string
 type extendedContext struct {
 	authInfo contextKey
 	host     contextKey
+	client   contextKey
 }
 
 type authInfo struct {
 	ID int32
+	// Family ID of the session of the access token, empty for users who are not activated
+	SessionID string
+	Role      role.Role
 }
 
 var (
 	extendedCtx = extendedContext{
 		authInfo: contextKey("auth_info"),
 		host:     contextKey("host"),
+		client:   contextKey("client"),
 	}
 )
 
@@ -52,3 +59,14 @@ func HostUrlOfRequest(ctx context.Context) string {
 
 	return hostInfo
 }
+
+// Get the client which sent the request from request context.
+// Exit if client info not found.
+func ClientOfRequest(ctx context.Context) auth.Client {
+	client, ok := ctx.Value(extendedCtx.client).(auth.Client)
+	if !ok {
+		log.Fatal().Msg("client info not found in request context")
+	}
+
+	return client
+}
@@ -13,6 +13,37 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for BatchTaskOperationType.
+const (
+	Create BatchTaskOperationType = "create"
+	Delete BatchTaskOperationType = "delete"
+	Update BatchTaskOperationType = "update"
+)
+
+// Defines values for BatchTaskResultStatus.
+const (
+	Failed     BatchTaskResultStatus = "failed"
+	RolledBack BatchTaskResultStatus = "rolled_back"
+	Succeeded  BatchTaskResultStatus = "succeeded"
+)
+
+// Defines values for PasswordErrorType.
+const (
+	IncorrectPassword PasswordErrorType = "IncorrectPassword"
+	NoPassword        PasswordErrorType = "NoPassword"
+	WeakPassword      PasswordErrorType = "WeakPassword"
+)
+
+// Defines values for PasswordViolation.
+const (
+	Breached               PasswordViolation = "breached"
+	InvalidCharacters      PasswordViolation = "invalid_characters"
+	TooFewCharacterClasses PasswordViolation = "too_few_character_classes"
+	TooLong                PasswordViolation = "too_long"
+	TooShort               PasswordViolation = "too_short"
+	TooWeak                PasswordViolation = "too_weak"
+)
+
 // Defines values for RegisterErrorType.
 const (
 	DuplicateEmail  RegisterErrorType = "DuplicateEmail"
@@ -20,17 +51,53 @@ const (
 	InvalidPassword RegisterErrorType = "InvalidPassword"
 )
 
+// Defines values for Role.
+const (
+	RoleAdmin Role = "admin"
+	RoleUser  Role = "user"
+)
+
 // Defines values for TokenErrorType.
 const (
 	ExpiredToken TokenErrorType = "ExpiredToken"
 	InvalidToken TokenErrorType = "InvalidToken"
 )
 
+// Defines values for DeliveryModeParam.
+const (
+	DeliveryModeParamDeepLink DeliveryModeParam = "deep_link"
+	DeliveryModeParamPopup    DeliveryModeParam = "popup"
+	DeliveryModeParamRedirect DeliveryModeParam = "redirect"
+)
+
+// Defines values for GetAdminUsersParamsStatus.
+const (
+	Active          GetAdminUsersParamsStatus = "active"
+	Disabled        GetAdminUsersParamsStatus = "disabled"
+	PendingDeletion GetAdminUsersParamsStatus = "pending_deletion"
+	Unactivated     GetAdminUsersParamsStatus = "unactivated"
+)
+
+// Defines values for GetAuthProviderAuthorizeParamsMode.
+const (
+	GetAuthProviderAuthorizeParamsModeDeepLink GetAuthProviderAuthorizeParamsMode = "deep_link"
+	GetAuthProviderAuthorizeParamsModePopup    GetAuthProviderAuthorizeParamsMode = "popup"
+	GetAuthProviderAuthorizeParamsModeRedirect GetAuthProviderAuthorizeParamsMode = "redirect"
+)
+
+// Defines values for PostProfileIdentitiesJSONBodyMode.
+const (
+	DeepLink PostProfileIdentitiesJSONBodyMode = "deep_link"
+	Popup    PostProfileIdentitiesJSONBodyMode = "popup"
+	Redirect PostProfileIdentitiesJSONBodyMode = "redirect"
+)
+
 // Defines values for GetTasksParamsSortBy.
 const (
 	CreatedAt GetTasksParamsSortBy = "created_at"
 	EndTime   GetTasksParamsSortBy = "end_time"
 	Priority  GetTasksParamsSortBy = "priority"
+	Relevance GetTasksParamsSortBy = "relevance"
 	StartTime GetTasksParamsSortBy = "start_time"
 )
 
@@ -40,20 +107,114 @@ const (
 	Desc GetTasksParamsSortOrder = "desc"
 )
 
+// AdminStats defines model for AdminStats.
+type AdminStats struct {
+	// ActiveSessions Sessions which have not expired
+	ActiveSessions int `json:"active_sessions"`
+
+	// ActiveUsers Users with a session used in the last 30 days
+	ActiveUsers   int `json:"active_users"`
+	FocusSessions int `json:"focus_sessions"`
+
+	// FocusTime Total focus time in seconds
+	FocusTime int `json:"focus_time"`
+
+	// Tasks Tasks which are not in the trash
+	Tasks int `json:"tasks"`
+	Users struct {
+		Activated int `json:"activated"`
+		Admins    int `json:"admins"`
+
+		// Disabled Deactivated by an admin
+		Disabled        int `json:"disabled"`
+		PendingDeletion int `json:"pending_deletion"`
+
+		// Recent Created in the last 30 days
+		Recent int `json:"recent"`
+		Total  int `json:"total"`
+	} `json:"users"`
+}
+
+// AdminUser defines model for AdminUser.
+type AdminUser struct {
+	// ActiveSessions Number of sessions which have not expired
+	ActiveSessions int        `json:"active_sessions"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+
+	// DeletionScheduledAt When the account will be erased, absent unless its deletion was requested
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+
+	// DisabledAt When the account was deactivated, absent unless it is
+	DisabledAt  *time.Time `json:"disabled_at,omitempty"`
+	DisplayName *string    `json:"display_name,omitempty"`
+	Email       *string    `json:"email,omitempty"`
+	ID          int32      `json:"id"`
+
+	// IsActivated Whether the user's email is activated
+	IsActivated bool `json:"is_activated"`
+
+	// Role Role of the user, admins can also use the operations tagged `admin`
+	Role Role `json:"role"`
+}
+
 // AuthTokens defines model for AuthTokens.
 type AuthTokens struct {
 	AccessToken  *string `json:"access_token,omitempty"`
 	RefreshToken *string `json:"refresh_token,omitempty"`
 }
 
+// BatchTaskOperation defines model for BatchTaskOperation.
+type BatchTaskOperation struct {
+	// Id ID of the task to update or delete, ignored for create
+	Id   *int32                 `json:"id,omitempty"`
+	Op   BatchTaskOperationType `json:"op"`
+	Task *UpdateTaskRequest     `json:"task,omitempty"`
+}
+
+// BatchTaskOperationType defines model for BatchTaskOperationType.
+type BatchTaskOperationType string
+
+// BatchTaskRequest defines model for BatchTaskRequest.
+type BatchTaskRequest struct {
+	BulkUpdate *BulkTaskUpdate       `json:"bulk_update,omitempty"`
+	Operations *[]BatchTaskOperation `json:"operations,omitempty"`
+}
+
+// BatchTaskResponse defines model for BatchTaskResponse.
+type BatchTaskResponse struct {
+	Results *[]BatchTaskResult `json:"results,omitempty"`
+}
+
+// BatchTaskResult defines model for BatchTaskResult.
+type BatchTaskResult struct {
+	Error *string `json:"error,omitempty"`
+
+	// Id ID of the affected task
+	Id *int32 `json:"id,omitempty"`
+
+	// Index Position of the operation in the request, bulk update results come after the listed operations
+	Index  *int                    `json:"index,omitempty"`
+	Op     *BatchTaskOperationType `json:"op,omitempty"`
+	Status *BatchTaskResultStatus  `json:"status,omitempty"`
+}
+
+// BatchTaskResultStatus defines model for BatchTaskResultStatus.
+type BatchTaskResultStatus string
+
+// BulkTaskUpdate defines model for BulkTaskUpdate.
+type BulkTaskUpdate struct {
+	Changes UpdateTaskRequest `json:"changes"`
+	Ids     []int32           `json:"ids"`
+}
+
 // CreateFocusSessionRequest defines model for CreateFocusSessionRequest.
 type CreateFocusSessionRequest struct {
-	// BreakDuration Break duration in seconds
+	// BreakDuration Break duration in seconds, the default of the user when missing, if any
 	BreakDuration *int32 `json:"break_duration,omitempty"`
 	TaskId        int32  `json:"task_id"`
 
-	// TimerDuration Duration in seconds
-	TimerDuration int32 `json:"timer_duration"`
+	// TimerDuration Duration in seconds, the default of the user when missing, or 25 minutes
+	TimerDuration *int32 `json:"timer_duration,omitempty"`
 }
 
 // CreateTaskRequest defines model for CreateTaskRequest.
@@ -122,33 +283,209 @@ type FocusSession struct {
 // FocusSessionStatus defines model for FocusSessionStatus.
 type FocusSessionStatus = string
 
+// JsonWebKeySet defines model for JsonWebKeySet.
+type JsonWebKeySet struct {
+	Keys []map[string]interface{} `json:"keys"`
+}
+
+// LinkedIdentity defines model for LinkedIdentity.
+type LinkedIdentity struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Email Email given by the provider on the last login
+	Email      *string    `json:"email,omitempty"`
+	Id         *int32     `json:"id,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Provider   *string    `json:"provider,omitempty"`
+}
+
+// LoginResponse defines model for LoginResponse.
+type LoginResponse struct {
+	AccessToken *string `json:"access_token,omitempty"`
+
+	// IsActivated Whether the user's email is activated
+	IsActivated *bool `json:"is_activated,omitempty"`
+
+	// MfaRequired Whether the login needs a second factor. If so, no auth tokens are returned,
+	// `mfa_token` must be exchanged for them at `/login/2fa`.
+	MfaRequired *bool `json:"mfa_required,omitempty"`
+
+	// MfaToken Short-lived token proving the password was verified
+	MfaToken     *string `json:"mfa_token,omitempty"`
+	RefreshToken *string `json:"refresh_token,omitempty"`
+}
+
+// MfaCodeRequest defines model for MfaCodeRequest.
+type MfaCodeRequest struct {
+	// Code TOTP code, or a recovery code where accepted
+	Code string `json:"code"`
+}
+
 // PaginationResponse defines model for PaginationResponse.
 type PaginationResponse struct {
 	// Limit Number of items per page
 	Limit *int `json:"limit,omitempty"`
 
-	// Page Current page number
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+
+	// Page Current page number, absent when paginating by cursor
 	Page *int `json:"page,omitempty"`
 
-	// Total Total number of items
+	// Total Total number of items, only present when `include_total` is set
 	Total *int `json:"total,omitempty"`
 
-	// TotalPages Total number of pages
+	// TotalPages Total number of pages, only present when `include_total` is set
 	TotalPages *int `json:"total_pages,omitempty"`
 }
 
+// Passkey defines model for Passkey.
+type Passkey struct {
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	Id         *int32     `json:"id,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Name       *string    `json:"name,omitempty"`
+}
+
+// PasskeyAssertion Credential returned by `navigator.credentials.get()`, in its JSON serialization
+type PasskeyAssertion struct {
+	// Id Base64url encoded credential ID
+	Id       string `json:"id"`
+	Response struct {
+		// AuthenticatorData Base64url encoded
+		AuthenticatorData string `json:"authenticatorData"`
+
+		// ClientDataJSON Base64url encoded
+		ClientDataJSON string `json:"clientDataJSON"`
+
+		// Signature Base64url encoded
+		Signature string `json:"signature"`
+
+		// UserHandle Base64url encoded
+		UserHandle *string `json:"userHandle,omitempty"`
+	} `json:"response"`
+	Type *string `json:"type,omitempty"`
+}
+
+// PasskeyAttestation Credential returned by `navigator.credentials.create()`, in its JSON serialization
+type PasskeyAttestation struct {
+	Id       string `json:"id"`
+	Response struct {
+		// AttestationObject Base64url encoded
+		AttestationObject string `json:"attestationObject"`
+
+		// ClientDataJSON Base64url encoded
+		ClientDataJSON string `json:"clientDataJSON"`
+	} `json:"response"`
+	Type *string `json:"type,omitempty"`
+}
+
+// PasskeyOptions defines model for PasskeyOptions.
+type PasskeyOptions struct {
+	// ChallengeToken Token to complete the ceremony with, along with the credential
+	ChallengeToken *string                 `json:"challenge_token,omitempty"`
+	Options        *map[string]interface{} `json:"options,omitempty"`
+}
+
+// PasswordError defines model for PasswordError.
+type PasswordError struct {
+	Message *string            `json:"message,omitempty"`
+	Type    *PasswordErrorType `json:"type,omitempty"`
+
+	// Violations Rules of the password policy the new password breaks
+	Violations *[]PasswordViolation `json:"violations,omitempty"`
+}
+
+// PasswordErrorType defines model for PasswordError.Type.
+type PasswordErrorType string
+
+// PasswordViolation A rule of the password policy:
+// length in characters and bytes, control characters, character classes,
+// estimated strength, and known breached passwords.
+type PasswordViolation string
+
+// ProfileUpdate Changes of the profile, the fields which are missing are left unchanged.
+// Empty strings and zero durations clear the field.
+type ProfileUpdate struct {
+	// AvatarUrl Absolute http(s) URL of the picture of the user
+	AvatarUrl *string `json:"avatar_url,omitempty"`
+
+	// DefaultBreakDuration Break duration in seconds, from 60 to 14400
+	DefaultBreakDuration *int32 `json:"default_break_duration,omitempty"`
+
+	// DefaultFocusDuration Duration in seconds, from 60 to 14400
+	DefaultFocusDuration *int32  `json:"default_focus_duration,omitempty"`
+	DisplayName          *string `json:"display_name,omitempty"`
+
+	// Locale BCP 47 language tag, e.g. `en-US`
+	Locale *string `json:"locale,omitempty"`
+
+	// Timezone IANA time zone, e.g. `Europe/Paris`
+	Timezone *string `json:"timezone,omitempty"`
+
+	// WeekStartDay First day of the week, from 0 for Sunday to 6 for Saturday
+	WeekStartDay *int32 `json:"week_start_day,omitempty"`
+}
+
 // RegisterError defines model for RegisterError.
 type RegisterError struct {
 	Message *string            `json:"message,omitempty"`
 	Type    *RegisterErrorType `json:"type,omitempty"`
+
+	// Violations Rules of the password policy the password breaks, when it is invalid
+	Violations *[]PasswordViolation `json:"violations,omitempty"`
 }
 
 // RegisterErrorType defines model for RegisterError.Type.
 type RegisterErrorType string
 
+// Role Role of the user, admins can also use the operations tagged `admin`
+type Role string
+
+// SecurityEvent defines model for SecurityEvent.
+type SecurityEvent struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Details Depends on the type, e.g. the `method` of a login or the `reason` of a failed one
+	Details   *map[string]interface{} `json:"details,omitempty"`
+	ID        int32                   `json:"id"`
+	IpAddress *string                 `json:"ip_address,omitempty"`
+
+	// Type Type of the event, such as `registered`, `account_activated`, `login_succeeded`, `login_failed`, `logout`,
+	// `refresh_token_rotated`, `password_reset_requested`, `password_reset`, `password_changed`,
+	// `identity_linked` or `account_deactivated`
+	Type      string  `json:"type"`
+	UserAgent *string `json:"user_agent,omitempty"`
+
+	// UserId Absent for events of erased accounts and of the system
+	UserId *int32 `json:"user_id,omitempty"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	// CreatedAt When the user logged in
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Current Whether this is the session of the access token of the request
+	Current *bool `json:"current,omitempty"`
+
+	// DeviceName Readable name of the device, derived from its user agent
+	DeviceName *string    `json:"device_name,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	Id         *int32     `json:"id,omitempty"`
+	IpAddress  *string    `json:"ip_address,omitempty"`
+
+	// LastUsedAt When the session was last refreshed
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	UserAgent  *string    `json:"user_agent,omitempty"`
+}
+
 // Task defines model for Task.
 type Task struct {
-	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// DeletedAt When the task was moved to the trash
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
 	Description *string    `json:"description,omitempty"`
 	EndTime     *time.Time `json:"end_time,omitempty"`
 
@@ -157,18 +494,70 @@ type Task struct {
 	Id            *int32        `json:"id,omitempty"`
 	Name          *string       `json:"name,omitempty"`
 	Priority      *TaskPriority `json:"priority,omitempty"`
-	StartTime     *time.Time    `json:"start_time,omitempty"`
-	Status        *TaskStatus   `json:"status,omitempty"`
-	UpdatedAt     *time.Time    `json:"updated_at,omitempty"`
-	UserId        *int32        `json:"user_id,omitempty"`
+
+	// Snippet Only present in search results. HTML escaped excerpt of the matched text,
+	// with the matched terms wrapped in `<mark>` tags.
+	Snippet   *string     `json:"snippet,omitempty"`
+	StartTime *time.Time  `json:"start_time,omitempty"`
+	Status    *TaskStatus `json:"status,omitempty"`
+	UpdatedAt *time.Time  `json:"updated_at,omitempty"`
+	UserId    *int32      `json:"user_id,omitempty"`
 }
 
 // TaskPriority defines model for TaskPriority.
 type TaskPriority = string
 
+// TaskSortField defines model for TaskSortField.
+type TaskSortField = string
+
+// TaskSortOrder defines model for TaskSortOrder.
+type TaskSortOrder = string
+
 // TaskStatus defines model for TaskStatus.
 type TaskStatus = string
 
+// TaskView defines model for TaskView.
+type TaskView struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// EndDate Only tasks ending at or before this date, same format as `start_date`
+	EndDate       *string        `json:"end_date,omitempty"`
+	ExcludeStatus *TaskStatus    `json:"exclude_status,omitempty"`
+	Id            *int32         `json:"id,omitempty"`
+	Name          string         `json:"name"`
+	Priority      *TaskPriority  `json:"priority,omitempty"`
+	Search        *string        `json:"search,omitempty"`
+	SortBy        *TaskSortField `json:"sort_by,omitempty"`
+	SortOrder     *TaskSortOrder `json:"sort_order,omitempty"`
+
+	// StartDate Only tasks starting at or after this date. Either an absolute date such as `2025-01-31`,
+	// or a date relative to the time of the request: `now`, `today`, `week` (start of the week)
+	// or `month` (start of the month), followed by offsets in hours, days, weeks or months, e.g. `today+7d` or `week+1w-1d`.
+	// Dates are resolved in the `timezone` of the user, UTC by default, and weeks start on their `week_start_day`.
+	StartDate *string     `json:"start_date,omitempty"`
+	Status    *TaskStatus `json:"status,omitempty"`
+	UpdatedAt *time.Time  `json:"updated_at,omitempty"`
+}
+
+// TaskViewRequest defines model for TaskViewRequest.
+type TaskViewRequest struct {
+	// EndDate Only tasks ending at or before this date, same format as `start_date`
+	EndDate       *string        `json:"end_date,omitempty"`
+	ExcludeStatus *TaskStatus    `json:"exclude_status,omitempty"`
+	Name          string         `json:"name"`
+	Priority      *TaskPriority  `json:"priority,omitempty"`
+	Search        *string        `json:"search,omitempty"`
+	SortBy        *TaskSortField `json:"sort_by,omitempty"`
+	SortOrder     *TaskSortOrder `json:"sort_order,omitempty"`
+
+	// StartDate Only tasks starting at or after this date. Either an absolute date such as `2025-01-31`,
+	// or a date relative to the time of the request: `now`, `today`, `week` (start of the week)
+	// or `month` (start of the month), followed by offsets in hours, days, weeks or months, e.g. `today+7d` or `week+1w-1d`.
+	// Dates are resolved in the `timezone` of the user, UTC by default, and weeks start on their `week_start_day`.
+	StartDate *string     `json:"start_date,omitempty"`
+	Status    *TaskStatus `json:"status,omitempty"`
+}
+
 // TokenError defines model for TokenError.
 type TokenError struct {
 	Message *string         `json:"message,omitempty"`
@@ -178,6 +567,15 @@ type TokenError struct {
 // TokenErrorType defines model for TokenError.Type.
 type TokenErrorType string
 
+// TotpEnrollment defines model for TotpEnrollment.
+type TotpEnrollment struct {
+	// OtpauthUri URI to be shown as a QR code
+	OtpauthUri *string `json:"otpauth_uri,omitempty"`
+
+	// Secret Base32 encoded secret, for authenticator apps which cannot scan the URI
+	Secret *string `json:"secret,omitempty"`
+}
+
 // UpdateTaskRequest defines model for UpdateTaskRequest.
 type UpdateTaskRequest struct {
 	Description *string    `json:"description,omitempty"`
@@ -193,23 +591,77 @@ type UpdateTaskRequest struct {
 
 // User defines model for User.
 type User struct {
+	// AvatarUrl Absolute http(s) URL of the picture of the user
+	AvatarUrl *string    `json:"avatar_url,omitempty"`
 	CreatedAt *time.Time `json:"created_at,omitempty"`
-	Email     *string    `json:"email,omitempty"`
-	ID        *int32     `json:"id,omitempty"`
+
+	// DefaultBreakDuration Break duration in seconds of the focus sessions started without one
+	DefaultBreakDuration *int32 `json:"default_break_duration,omitempty"`
+
+	// DefaultFocusDuration Duration in seconds of the focus sessions started without one
+	DefaultFocusDuration *int32  `json:"default_focus_duration,omitempty"`
+	DisplayName          *string `json:"display_name,omitempty"`
+	Email                *string `json:"email,omitempty"`
+	ID                   *int32  `json:"id,omitempty"`
+
+	// Identities Identities of external providers linked to the account
+	Identities *[]LinkedIdentity `json:"identities,omitempty"`
 
 	// IsActivated Whether the user's email is activated
 	IsActivated *bool `json:"is_activated,omitempty"`
+
+	// Locale BCP 47 language tag, e.g. `en-US`
+	Locale *string `json:"locale,omitempty"`
+
+	// Role Role of the user, admins can also use the operations tagged `admin`
+	Role *Role `json:"role,omitempty"`
+
+	// Timezone IANA time zone, e.g. `Europe/Paris`
+	Timezone *string `json:"timezone,omitempty"`
+
+	// WeekStartDay First day of the week, from 0 for Sunday to 6 for Saturday
+	WeekStartDay *int32 `json:"week_start_day,omitempty"`
 }
 
+// CodeChallengeParam defines model for CodeChallengeParam.
+type CodeChallengeParam = string
+
+// CursorParam defines model for CursorParam.
+type CursorParam = string
+
+// DeliveryModeParam defines model for DeliveryModeParam.
+type DeliveryModeParam string
+
+// IncludeTotalParam defines model for IncludeTotalParam.
+type IncludeTotalParam = bool
+
 // LimitParam defines model for LimitParam.
 type LimitParam = int
 
 // PageParam defines model for PageParam.
 type PageParam = int
 
+// ProviderParam defines model for ProviderParam.
+type ProviderParam = string
+
+// ReturnToParam defines model for ReturnToParam.
+type ReturnToParam = string
+
+// SecurityEventTypeParam defines model for SecurityEventTypeParam.
+type SecurityEventTypeParam = []string
+
+// UserIdParam defines model for UserIdParam.
+type UserIdParam = int32
+
+// AccountDisabled defines model for AccountDisabled.
+type AccountDisabled = DefaultResponse
+
 // Forbidden defines model for Forbidden.
 type Forbidden = DefaultResponse
 
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = DefaultResponse
+
 // PostActivationJSONBody defines parameters for PostActivation.
 type PostActivationJSONBody struct {
 	// Token Activation token sent via email
@@ -219,19 +671,93 @@ type PostActivationJSONBody struct {
 	UserId int32 `json:"user_id"`
 }
 
+// GetAdminSecurityEventsParams defines parameters for GetAdminSecurityEvents.
+type GetAdminSecurityEventsParams struct {
+	UserId    *int32  `form:"user_id,omitempty" json:"user_id,omitempty"`
+	IpAddress *string `form:"ip_address,omitempty" json:"ip_address,omitempty"`
+
+	// Type Only the events of these types, e.g. `login_succeeded` and `login_failed`
+	Type *SecurityEventTypeParam `form:"type,omitempty" json:"type,omitempty"`
+
+	// Page Page number
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor returned as `next_cursor` by the previous page.
+	// When set, the page continues after the cursor and `page` is ignored.
+	// A cursor is only valid for the same sort order it was returned with.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Whether to count the total number of items and pages
+	IncludeTotal *IncludeTotalParam `form:"include_total,omitempty" json:"include_total,omitempty"`
+}
+
+// GetAdminUsersParams defines parameters for GetAdminUsers.
+type GetAdminUsersParams struct {
+	// Q Part of the email or display name, case insensitive
+	Q    *string `form:"q,omitempty" json:"q,omitempty"`
+	Role *Role   `form:"role,omitempty" json:"role,omitempty"`
+
+	// Status - `active`: activated, and neither deactivated nor pending deletion
+	// - `unactivated`: email not confirmed yet
+	// - `disabled`: deactivated by an admin
+	// - `pending_deletion`: deletion requested, see `DELETE /profile`
+	Status *GetAdminUsersParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Page Page number
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor returned as `next_cursor` by the previous page.
+	// When set, the page continues after the cursor and `page` is ignored.
+	// A cursor is only valid for the same sort order it was returned with.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Whether to count the total number of items and pages
+	IncludeTotal *IncludeTotalParam `form:"include_total,omitempty" json:"include_total,omitempty"`
+}
+
+// GetAdminUsersParamsStatus defines parameters for GetAdminUsers.
+type GetAdminUsersParamsStatus string
+
 // GetAnalyticsFocusParams defines parameters for GetAnalyticsFocus.
 type GetAnalyticsFocusParams struct {
 	StartDate *time.Time `form:"start_date,omitempty" json:"start_date,omitempty"`
 	EndDate   *time.Time `form:"end_date,omitempty" json:"end_date,omitempty"`
 }
 
-// GetAuthGoogleCallbackParams defines parameters for GetAuthGoogleCallback.
-type GetAuthGoogleCallbackParams struct {
-	// Code Authorization code from Google
-	Code string `form:"code" json:"code"`
+// PostAuthExchangeJSONBody defines parameters for PostAuthExchange.
+type PostAuthExchangeJSONBody struct {
+	Code string `json:"code"`
 
-	// State State parameter for CSRF protection
-	State string `form:"state" json:"state"`
+	// CodeVerifier PKCE verifier of the `code_challenge` given to the authorization, required if one was given
+	CodeVerifier *string `json:"code_verifier,omitempty"`
+}
+
+// PostAuthMagicLinkJSONBody defines parameters for PostAuthMagicLink.
+type PostAuthMagicLinkJSONBody struct {
+	Email string `json:"email"`
+}
+
+// PostAuthMagicLinkConfirmJSONBody defines parameters for PostAuthMagicLinkConfirm.
+type PostAuthMagicLinkConfirmJSONBody struct {
+	// Token Login token received via email
+	Token string `json:"token"`
+
+	// UserId User ID
+	UserId int32 `json:"user_id"`
+}
+
+// PostAuthPasskeyLoginJSONBody defines parameters for PostAuthPasskeyLogin.
+type PostAuthPasskeyLoginJSONBody struct {
+	ChallengeToken string `json:"challenge_token"`
+
+	// Credential Credential returned by `navigator.credentials.get()`, in its JSON serialization
+	Credential PasskeyAssertion `json:"credential"`
 }
 
 // PostAuthPasswordResetJSONBody defines parameters for PostAuthPasswordReset.
@@ -271,12 +797,73 @@ type PostAuthRefreshTokenParams struct {
 	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty"`
 }
 
+// GetAuthProviderAuthorizeParams defines parameters for GetAuthProviderAuthorize.
+type GetAuthProviderAuthorizeParams struct {
+	// Mode How the result is delivered to the application: posted to the opener of a popup,
+	// by a redirect to a frontend URL, or by a deep link of a mobile app.
+	Mode *GetAuthProviderAuthorizeParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// ReturnTo Origin of the opener of the popup, allowed by `ALLOW_ORIGINS`, optional when a single origin is allowed.
+	// URL to redirect to, allowed by `OAUTH_REDIRECT_URLS`, or deep link of a scheme allowed by `OAUTH_DEEP_LINK_SCHEMES`.
+	ReturnTo *ReturnToParam `form:"return_to,omitempty" json:"return_to,omitempty"`
+
+	// CodeChallenge S256 PKCE challenge binding the exchange code to the application, required for deep links.
+	CodeChallenge *CodeChallengeParam `form:"code_challenge,omitempty" json:"code_challenge,omitempty"`
+
+	// SecFetchMode Set by the browser, must be `navigate` when given
+	SecFetchMode *string `json:"Sec-Fetch-Mode,omitempty"`
+}
+
+// GetAuthProviderAuthorizeParamsMode defines parameters for GetAuthProviderAuthorize.
+type GetAuthProviderAuthorizeParamsMode string
+
+// GetAuthProviderCallbackParams defines parameters for GetAuthProviderCallback.
+type GetAuthProviderCallbackParams struct {
+	// Code Authorization code from the provider, missing when the authorization failed
+	Code *string `form:"code,omitempty" json:"code,omitempty"`
+
+	// State State parameter for CSRF protection
+	State string `form:"state" json:"state"`
+
+	// Error Error from the provider, such as `access_denied`
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
+
+	// AuthBinding Binding set when the authorization started, the state is rejected without it
+	AuthBinding *string `form:"auth_binding,omitempty" json:"auth_binding,omitempty"`
+}
+
+// GetFocusSessionsParams defines parameters for GetFocusSessions.
+type GetFocusSessionsParams struct {
+	// Page Page number
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor returned as `next_cursor` by the previous page.
+	// When set, the page continues after the cursor and `page` is ignored.
+	// A cursor is only valid for the same sort order it was returned with.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Whether to count the total number of items and pages
+	IncludeTotal *IncludeTotalParam `form:"include_total,omitempty" json:"include_total,omitempty"`
+
+	// TaskId Only list the sessions of this task
+	TaskId *int32 `form:"task_id,omitempty" json:"task_id,omitempty"`
+}
+
 // PostLoginJSONBody defines parameters for PostLogin.
 type PostLoginJSONBody struct {
 	Email    *string `json:"email,omitempty"`
 	Password *string `json:"password,omitempty"`
 }
 
+// PostLogin2faJSONBody defines parameters for PostLogin2fa.
+type PostLogin2faJSONBody struct {
+	Code     string `json:"code"`
+	MfaToken string `json:"mfa_token"`
+}
+
 // PostLogoutJSONBody defines parameters for PostLogout.
 type PostLogoutJSONBody struct {
 	RefreshToken *string `json:"refresh_token,omitempty"`
@@ -288,12 +875,109 @@ type PostLogoutParams struct {
 	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty"`
 }
 
+// DeleteProfileJSONBody defines parameters for DeleteProfile.
+type DeleteProfileJSONBody struct {
+	Password *string `json:"password,omitempty"`
+}
+
+// PostProfileEmailJSONBody defines parameters for PostProfileEmail.
+type PostProfileEmailJSONBody struct {
+	Email    string  `json:"email"`
+	Password *string `json:"password,omitempty"`
+}
+
+// PostProfileEmailConfirmJSONBody defines parameters for PostProfileEmailConfirm.
+type PostProfileEmailConfirmJSONBody struct {
+	// Token Token received via the new email
+	Token string `json:"token"`
+}
+
+// PostProfileIdentitiesJSONBody defines parameters for PostProfileIdentities.
+type PostProfileIdentitiesJSONBody struct {
+	// Mode How the result is delivered, as for `/auth/{provider}/authorize`
+	Mode *PostProfileIdentitiesJSONBodyMode `json:"mode,omitempty"`
+
+	// Provider Name of the provider, such as `google`
+	Provider string `json:"provider"`
+
+	// ReturnTo Where the result is delivered, as for `/auth/{provider}/authorize`
+	ReturnTo *string `json:"return_to,omitempty"`
+}
+
+// PostProfileIdentitiesJSONBodyMode defines parameters for PostProfileIdentities.
+type PostProfileIdentitiesJSONBodyMode string
+
+// PostProfilePasskeysJSONBody defines parameters for PostProfilePasskeys.
+type PostProfilePasskeysJSONBody struct {
+	ChallengeToken string `json:"challenge_token"`
+
+	// Credential Credential returned by `navigator.credentials.create()`, in its JSON serialization
+	Credential PasskeyAttestation `json:"credential"`
+
+	// Name Name given by the user to recognize the passkey
+	Name *string `json:"name,omitempty"`
+}
+
+// PostProfilePasswordJSONBody defines parameters for PostProfilePassword.
+type PostProfilePasswordJSONBody struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+// GetProfileSecurityEventsParams defines parameters for GetProfileSecurityEvents.
+type GetProfileSecurityEventsParams struct {
+	// Type Only the events of these types, e.g. `login_succeeded` and `login_failed`
+	Type *SecurityEventTypeParam `form:"type,omitempty" json:"type,omitempty"`
+
+	// Page Page number
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor returned as `next_cursor` by the previous page.
+	// When set, the page continues after the cursor and `page` is ignored.
+	// A cursor is only valid for the same sort order it was returned with.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Whether to count the total number of items and pages
+	IncludeTotal *IncludeTotalParam `form:"include_total,omitempty" json:"include_total,omitempty"`
+}
+
 // PostRegisterJSONBody defines parameters for PostRegister.
 type PostRegisterJSONBody struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
 
+// PostSessionsLogoutOthersJSONBody defines parameters for PostSessionsLogoutOthers.
+type PostSessionsLogoutOthersJSONBody struct {
+	RefreshToken *string `json:"refresh_token,omitempty"`
+}
+
+// PostSessionsLogoutOthersParams defines parameters for PostSessionsLogoutOthers.
+type PostSessionsLogoutOthersParams struct {
+	// RefreshToken Refresh token stored in cookie
+	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty"`
+}
+
+// GetTaskViewsIdTasksParams defines parameters for GetTaskViewsIdTasks.
+type GetTaskViewsIdTasksParams struct {
+	// Page Page number
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor returned as `next_cursor` by the previous page.
+	// When set, the page continues after the cursor and `page` is ignored.
+	// A cursor is only valid for the same sort order it was returned with.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Whether to count the total number of items and pages
+	IncludeTotal *IncludeTotalParam `form:"include_total,omitempty" json:"include_total,omitempty"`
+}
+
 // GetTasksParams defines parameters for GetTasks.
 type GetTasksParams struct {
 	// Page Page number
@@ -302,12 +986,25 @@ type GetTasksParams struct {
 	// Limit Number of items per page
 	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
 
-	// Search Search term to filter tasks by name or description
+	// Cursor Opaque cursor returned as `next_cursor` by the previous page.
+	// When set, the page continues after the cursor and `page` is ignored.
+	// A cursor is only valid for the same sort order it was returned with.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Whether to count the total number of items and pages
+	IncludeTotal *IncludeTotalParam `form:"include_total,omitempty" json:"include_total,omitempty"`
+
+	// Search Search term to filter tasks by name or description.
+	// Words ending with `*` match as prefixes and words inside double quotes match as a phrase.
+	// Results are ordered by relevance unless `sort_by` is set.
 	Search *string `form:"search,omitempty" json:"search,omitempty"`
 
 	// Status Filter tasks by status
 	Status *TaskStatus `form:"status,omitempty" json:"status,omitempty"`
 
+	// ExcludeStatus Filter out tasks with this status
+	ExcludeStatus *TaskStatus `form:"exclude_status,omitempty" json:"exclude_status,omitempty"`
+
 	// Priority Filter tasks by priority
 	Priority *TaskPriority `form:"priority,omitempty" json:"priority,omitempty"`
 
@@ -317,7 +1014,7 @@ type GetTasksParams struct {
 	// EndDate Filter tasks by end date (inclusive)
 	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
 
-	// SortBy Field to sort by
+	// SortBy Field to sort by, defaults to `relevance` when searching and `created_at` otherwise
 	SortBy *GetTasksParamsSortBy `form:"sort_by,omitempty" json:"sort_by,omitempty"`
 
 	// SortOrder Sort order
@@ -330,9 +1027,38 @@ type GetTasksParamsSortBy string
 // GetTasksParamsSortOrder defines parameters for GetTasks.
 type GetTasksParamsSortOrder string
 
+// GetTasksTrashParams defines parameters for GetTasksTrash.
+type GetTasksTrashParams struct {
+	// Page Page number
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor returned as `next_cursor` by the previous page.
+	// When set, the page continues after the cursor and `page` is ignored.
+	// A cursor is only valid for the same sort order it was returned with.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Whether to count the total number of items and pages
+	IncludeTotal *IncludeTotalParam `form:"include_total,omitempty" json:"include_total,omitempty"`
+}
+
 // PostActivationJSONRequestBody defines body for PostActivation for application/json ContentType.
 type PostActivationJSONRequestBody PostActivationJSONBody
 
+// PostAuthExchangeJSONRequestBody defines body for PostAuthExchange for application/json ContentType.
+type PostAuthExchangeJSONRequestBody PostAuthExchangeJSONBody
+
+// PostAuthMagicLinkJSONRequestBody defines body for PostAuthMagicLink for application/json ContentType.
+type PostAuthMagicLinkJSONRequestBody PostAuthMagicLinkJSONBody
+
+// PostAuthMagicLinkConfirmJSONRequestBody defines body for PostAuthMagicLinkConfirm for application/json ContentType.
+type PostAuthMagicLinkConfirmJSONRequestBody PostAuthMagicLinkConfirmJSONBody
+
+// PostAuthPasskeyLoginJSONRequestBody defines body for PostAuthPasskeyLogin for application/json ContentType.
+type PostAuthPasskeyLoginJSONRequestBody PostAuthPasskeyLoginJSONBody
+
 // PostAuthPasswordResetJSONRequestBody defines body for PostAuthPasswordReset for application/json ContentType.
 type PostAuthPasswordResetJSONRequestBody PostAuthPasswordResetJSONBody
 
@@ -354,14 +1080,56 @@ type PostFocusSessionsIdEndJSONRequestBody = EndFocusSessionRequest
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody PostLoginJSONBody
 
+// PostLogin2faJSONRequestBody defines body for PostLogin2fa for application/json ContentType.
+type PostLogin2faJSONRequestBody PostLogin2faJSONBody
+
 // PostLogoutJSONRequestBody defines body for PostLogout for application/json ContentType.
 type PostLogoutJSONRequestBody PostLogoutJSONBody
 
+// DeleteProfileJSONRequestBody defines body for DeleteProfile for application/json ContentType.
+type DeleteProfileJSONRequestBody DeleteProfileJSONBody
+
+// PatchProfileJSONRequestBody defines body for PatchProfile for application/json ContentType.
+type PatchProfileJSONRequestBody = ProfileUpdate
+
+// PostProfile2faDisableJSONRequestBody defines body for PostProfile2faDisable for application/json ContentType.
+type PostProfile2faDisableJSONRequestBody = MfaCodeRequest
+
+// PostProfile2faTotpConfirmJSONRequestBody defines body for PostProfile2faTotpConfirm for application/json ContentType.
+type PostProfile2faTotpConfirmJSONRequestBody = MfaCodeRequest
+
+// PostProfileEmailJSONRequestBody defines body for PostProfileEmail for application/json ContentType.
+type PostProfileEmailJSONRequestBody PostProfileEmailJSONBody
+
+// PostProfileEmailConfirmJSONRequestBody defines body for PostProfileEmailConfirm for application/json ContentType.
+type PostProfileEmailConfirmJSONRequestBody PostProfileEmailConfirmJSONBody
+
+// PostProfileIdentitiesJSONRequestBody defines body for PostProfileIdentities for application/json ContentType.
+type PostProfileIdentitiesJSONRequestBody PostProfileIdentitiesJSONBody
+
+// PostProfilePasskeysJSONRequestBody defines body for PostProfilePasskeys for application/json ContentType.
+type PostProfilePasskeysJSONRequestBody PostProfilePasskeysJSONBody
+
+// PostProfilePasswordJSONRequestBody defines body for PostProfilePassword for application/json ContentType.
+type PostProfilePasswordJSONRequestBody PostProfilePasswordJSONBody
+
 // PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
 type PostRegisterJSONRequestBody PostRegisterJSONBody
 
+// PostSessionsLogoutOthersJSONRequestBody defines body for PostSessionsLogoutOthers for application/json ContentType.
+type PostSessionsLogoutOthersJSONRequestBody PostSessionsLogoutOthersJSONBody
+
+// PostTaskViewsJSONRequestBody defines body for PostTaskViews for application/json ContentType.
+type PostTaskViewsJSONRequestBody = TaskViewRequest
+
+// PutTaskViewsIdJSONRequestBody defines body for PutTaskViewsId for application/json ContentType.
+type PutTaskViewsIdJSONRequestBody = TaskViewRequest
+
 // PostTasksJSONRequestBody defines body for PostTasks for application/json ContentType.
 type PostTasksJSONRequestBody = CreateTaskRequest
 
+// PostTasksBatchJSONRequestBody defines body for PostTasksBatch for application/json ContentType.
+type PostTasksBatchJSONRequestBody = BatchTaskRequest
+
 // PutTasksIdJSONRequestBody defines body for PutTasksId for application/json ContentType.
 type PutTasksIdJSONRequestBody = UpdateTaskRequest
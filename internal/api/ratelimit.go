@@ -0,0 +1,77 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"study-planner-api/internal/ratelimit"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Limits of the routes which send emails or check credentials,
+// the routes sending emails could otherwise be used to spam arbitrary inboxes
+var rateLimitRules = []ratelimit.Rule{
+	{
+		Method: http.MethodPost, Path: "/auth/password-reset", By: ratelimit.ByIP,
+		Limit: ratelimit.Limit{Burst: 5, Period: time.Hour},
+	},
+	{
+		Method: http.MethodPost, Path: "/auth/magic-link", By: ratelimit.ByIP,
+		Limit: ratelimit.Limit{Burst: 5, Period: time.Hour},
+	},
+	{
+		Method: http.MethodPost, Path: "/activation/email", By: ratelimit.ByUser,
+		Limit: ratelimit.Limit{Burst: 3, Period: time.Hour},
+	},
+	{
+		Method: http.MethodPost, Path: "/activation/email", By: ratelimit.ByIP,
+		Limit: ratelimit.Limit{Burst: 10, Period: time.Hour},
+	},
+	{
+		Method: http.MethodPost, Path: "/admin/users/:id/activation-email", By: ratelimit.ByUser,
+		Limit: ratelimit.Limit{Burst: 20, Period: time.Hour},
+	},
+	{
+		Method: http.MethodPost, Path: "/profile/email", By: ratelimit.ByUser,
+		Limit: ratelimit.Limit{Burst: 3, Period: time.Hour},
+	},
+	{
+		Method: http.MethodDelete, Path: "/profile", By: ratelimit.ByUser,
+		Limit: ratelimit.Limit{Burst: 5, Period: time.Minute * 15},
+	},
+	{
+		Method: http.MethodPost, Path: "/profile/password", By: ratelimit.ByUser,
+		Limit: ratelimit.Limit{Burst: 5, Period: time.Minute * 15},
+	},
+	{
+		Method: http.MethodPost, Path: "/register", By: ratelimit.ByIP,
+		Limit: ratelimit.Limit{Burst: 10, Period: time.Hour},
+	},
+	{
+		Method: http.MethodPost, Path: "/login/2fa", By: ratelimit.ByIP,
+		Limit: ratelimit.Limit{Burst: 10, Period: time.Minute},
+	},
+	{
+		Method: http.MethodPost, Path: "/auth/exchange", By: ratelimit.ByIP,
+		Limit: ratelimit.Limit{Burst: 20, Period: time.Minute},
+	},
+	{
+		Method: http.MethodPost, Path: "/auth/passkey/login", By: ratelimit.ByIP,
+		Limit: ratelimit.Limit{Burst: 20, Period: time.Minute},
+	},
+}
+
+// Identifies the principal of a request, users are only known once the request is authenticated
+func identifyPrincipal(c echo.Context, by ratelimit.Principal) (string, bool) {
+	switch by {
+	case ratelimit.ByUser:
+		info, ok := c.Request().Context().Value(extendedCtx.authInfo).(authInfo)
+		if !ok {
+			return "", false
+		}
+		return strconv.Itoa(int(info.ID)), true
+	default:
+		return c.RealIP(), true
+	}
+}
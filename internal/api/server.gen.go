@@ -15,6 +15,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
@@ -24,21 +25,57 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get the public keys used to verify the issued JWTs
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(ctx echo.Context) error
 	// Activate user account
 	// (POST /activation)
 	PostActivation(ctx echo.Context) error
 	// Send activation email
 	// (POST /activation/email)
 	PostActivationEmail(ctx echo.Context) error
+	// Get the audit events of every user
+	// (GET /admin/security-events)
+	GetAdminSecurityEvents(ctx echo.Context, params GetAdminSecurityEventsParams) error
+	// Get the usage counts of the whole system
+	// (GET /admin/stats)
+	GetAdminStats(ctx echo.Context) error
+	// List and search users
+	// (GET /admin/users)
+	GetAdminUsers(ctx echo.Context, params GetAdminUsersParams) error
+	// Get a user
+	// (GET /admin/users/{id})
+	GetAdminUsersId(ctx echo.Context, id UserIdParam) error
+	// Resend the activation email of a user
+	// (POST /admin/users/{id}/activation-email)
+	PostAdminUsersIdActivationEmail(ctx echo.Context, id UserIdParam) error
+	// Deactivate the account of a user
+	// (POST /admin/users/{id}/deactivate)
+	PostAdminUsersIdDeactivate(ctx echo.Context, id UserIdParam) error
+	// Reactivate the account of a user
+	// (POST /admin/users/{id}/reactivate)
+	PostAdminUsersIdReactivate(ctx echo.Context, id UserIdParam) error
+	// Log a user out of every session
+	// (DELETE /admin/users/{id}/sessions)
+	DeleteAdminUsersIdSessions(ctx echo.Context, id UserIdParam) error
 	// Get focus session analytics
 	// (GET /analytics/focus)
 	GetAnalyticsFocus(ctx echo.Context, params GetAnalyticsFocusParams) error
-	// Initiate Google OAuth2 login flow
-	// (GET /auth/google/authorize)
-	GetAuthGoogleAuthorize(ctx echo.Context) error
-	// Handle Google OAuth2 callback
-	// (GET /auth/google/callback)
-	GetAuthGoogleCallback(ctx echo.Context, params GetAuthGoogleCallbackParams) error
+	// Login with the exchange code of an external provider login
+	// (POST /auth/exchange)
+	PostAuthExchange(ctx echo.Context) error
+	// Request a login link by email
+	// (POST /auth/magic-link)
+	PostAuthMagicLink(ctx echo.Context) error
+	// Login using the token of a login link
+	// (POST /auth/magic-link/confirm)
+	PostAuthMagicLinkConfirm(ctx echo.Context) error
+	// Login with a passkey
+	// (POST /auth/passkey/login)
+	PostAuthPasskeyLogin(ctx echo.Context) error
+	// Start a passkey login
+	// (POST /auth/passkey/options)
+	PostAuthPasskeyOptions(ctx echo.Context) error
 	// Request password reset email
 	// (POST /auth/password-reset)
 	PostAuthPasswordReset(ctx echo.Context) error
@@ -51,6 +88,15 @@ type ServerInterface interface {
 	// Get new access and refresh tokens using refresh token
 	// (POST /auth/refresh-token)
 	PostAuthRefreshToken(ctx echo.Context, params PostAuthRefreshTokenParams) error
+	// Initiate a login with an external provider
+	// (GET /auth/{provider}/authorize)
+	GetAuthProviderAuthorize(ctx echo.Context, provider ProviderParam, params GetAuthProviderAuthorizeParams) error
+	// Handle the callback of an external provider
+	// (GET /auth/{provider}/callback)
+	GetAuthProviderCallback(ctx echo.Context, provider ProviderParam, params GetAuthProviderCallbackParams) error
+	// Get list of user's focus sessions
+	// (GET /focus-sessions)
+	GetFocusSessions(ctx echo.Context, params GetFocusSessionsParams) error
 	// Start a new focus session
 	// (POST /focus-sessions)
 	PostFocusSessions(ctx echo.Context) error
@@ -60,27 +106,111 @@ type ServerInterface interface {
 	// Login to the system
 	// (POST /login)
 	PostLogin(ctx echo.Context) error
+	// Complete a login with a second factor
+	// (POST /login/2fa)
+	PostLogin2fa(ctx echo.Context) error
 	// Logout and invalidate refresh token
 	// (POST /logout)
 	PostLogout(ctx echo.Context, params PostLogoutParams) error
+	// Delete the account
+	// (DELETE /profile)
+	DeleteProfile(ctx echo.Context) error
 	// Get user profile
 	// (GET /profile)
 	GetProfile(ctx echo.Context) error
+	// Update the profile and preferences
+	// (PATCH /profile)
+	PatchProfile(ctx echo.Context) error
+	// Disable 2FA
+	// (POST /profile/2fa/disable)
+	PostProfile2faDisable(ctx echo.Context) error
+	// Start the TOTP enrollment
+	// (POST /profile/2fa/totp)
+	PostProfile2faTotp(ctx echo.Context) error
+	// Confirm the TOTP enrollment and enable 2FA
+	// (POST /profile/2fa/totp/confirm)
+	PostProfile2faTotpConfirm(ctx echo.Context) error
+	// Request a change of the email
+	// (POST /profile/email)
+	PostProfileEmail(ctx echo.Context) error
+	// Confirm a change of the email
+	// (POST /profile/email/confirm)
+	PostProfileEmailConfirm(ctx echo.Context) error
+	// Start linking an identity of an external provider
+	// (POST /profile/identities)
+	PostProfileIdentities(ctx echo.Context) error
+	// Unlink an identity of an external provider
+	// (DELETE /profile/identities/{id})
+	DeleteProfileIdentitiesId(ctx echo.Context, id int32) error
+	// Get list of user's passkeys
+	// (GET /profile/passkeys)
+	GetProfilePasskeys(ctx echo.Context) error
+	// Register a passkey
+	// (POST /profile/passkeys)
+	PostProfilePasskeys(ctx echo.Context) error
+	// Start the registration of a passkey
+	// (POST /profile/passkeys/options)
+	PostProfilePasskeysOptions(ctx echo.Context) error
+	// Remove a passkey
+	// (DELETE /profile/passkeys/{id})
+	DeleteProfilePasskeysId(ctx echo.Context, id int32) error
+	// Change the password
+	// (POST /profile/password)
+	PostProfilePassword(ctx echo.Context) error
+	// Get the security history of the user
+	// (GET /profile/security-events)
+	GetProfileSecurityEvents(ctx echo.Context, params GetProfileSecurityEventsParams) error
 	// Register a new user
 	// (POST /register)
 	PostRegister(ctx echo.Context) error
+	// Get list of user's active sessions
+	// (GET /sessions)
+	GetSessions(ctx echo.Context) error
+	// Revoke every session except the current one
+	// (POST /sessions/logout-others)
+	PostSessionsLogoutOthers(ctx echo.Context, params PostSessionsLogoutOthersParams) error
+	// Revoke a session
+	// (DELETE /sessions/{id})
+	DeleteSessionsId(ctx echo.Context, id int32) error
+	// Get list of user's saved task views
+	// (GET /task-views)
+	GetTaskViews(ctx echo.Context) error
+	// Save a new task view
+	// (POST /task-views)
+	PostTaskViews(ctx echo.Context) error
+	// Delete a saved task view
+	// (DELETE /task-views/{id})
+	DeleteTaskViewsId(ctx echo.Context, id int32) error
+	// Get a saved task view
+	// (GET /task-views/{id})
+	GetTaskViewsId(ctx echo.Context, id int32) error
+	// Replace the name and filters of a saved task view
+	// (PUT /task-views/{id})
+	PutTaskViewsId(ctx echo.Context, id int32) error
+	// Get the tasks matching a saved task view
+	// (GET /task-views/{id}/tasks)
+	GetTaskViewsIdTasks(ctx echo.Context, id int32, params GetTaskViewsIdTasksParams) error
 	// Get list of user's tasks
 	// (GET /tasks)
 	GetTasks(ctx echo.Context, params GetTasksParams) error
 	// Create a new task
 	// (POST /tasks)
 	PostTasks(ctx echo.Context) error
-	// Delete a task
+	// Create, update and delete multiple tasks at once
+	// (POST /tasks/batch)
+	PostTasksBatch(ctx echo.Context) error
+	// Get list of user's deleted tasks
+	// (GET /tasks/trash)
+	GetTasksTrash(ctx echo.Context, params GetTasksTrashParams) error
+	// Move a task to the trash
 	// (DELETE /tasks/{id})
 	DeleteTasksId(ctx echo.Context, id int32) error
 	// Update an existing task
 	// (PUT /tasks/{id})
 	PutTasksId(ctx echo.Context, id int32) error
+	// Restore a deleted task from the trash
+	// (POST /tasks/{id}/restore)
+	PostTasksIdRestore(ctx echo.Context, id int32) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -88,6 +218,15 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetWellKnownJwksJson converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWellKnownJwksJson(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWellKnownJwksJson(ctx)
+	return err
+}
+
 // PostActivation converts echo context to params.
 func (w *ServerInterfaceWrapper) PostActivation(ctx echo.Context) error {
 	var err error
@@ -108,241 +247,239 @@ func (w *ServerInterfaceWrapper) PostActivationEmail(ctx echo.Context) error {
 	return err
 }
 
-// GetAnalyticsFocus converts echo context to params.
-func (w *ServerInterfaceWrapper) GetAnalyticsFocus(ctx echo.Context) error {
+// GetAdminSecurityEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminSecurityEvents(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(BearerAuthScopes, []string{"admin"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAnalyticsFocusParams
-	// ------------- Optional query parameter "start_date" -------------
+	var params GetAdminSecurityEventsParams
+	// ------------- Optional query parameter "user_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "start_date", ctx.QueryParams(), &params.StartDate)
+	err = runtime.BindQueryParameter("form", true, false, "user_id", ctx.QueryParams(), &params.UserId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_date: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
 	}
 
-	// ------------- Optional query parameter "end_date" -------------
+	// ------------- Optional query parameter "ip_address" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "end_date", ctx.QueryParams(), &params.EndDate)
+	err = runtime.BindQueryParameter("form", true, false, "ip_address", ctx.QueryParams(), &params.IpAddress)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_date: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ip_address: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetAnalyticsFocus(ctx, params)
-	return err
-}
+	// ------------- Optional query parameter "type" -------------
 
-// GetAuthGoogleAuthorize converts echo context to params.
-func (w *ServerInterfaceWrapper) GetAuthGoogleAuthorize(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetAuthGoogleAuthorize(ctx)
-	return err
-}
+	// ------------- Optional query parameter "page" -------------
 
-// GetAuthGoogleCallback converts echo context to params.
-func (w *ServerInterfaceWrapper) GetAuthGoogleCallback(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAuthGoogleCallbackParams
-	// ------------- Required query parameter "code" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "code", ctx.QueryParams(), &params.Code)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Required query parameter "state" -------------
+	// ------------- Optional query parameter "cursor" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "state", ctx.QueryParams(), &params.State)
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetAuthGoogleCallback(ctx, params)
-	return err
-}
+	// ------------- Optional query parameter "include_total" -------------
 
-// PostAuthPasswordReset converts echo context to params.
-func (w *ServerInterfaceWrapper) PostAuthPasswordReset(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "include_total", ctx.QueryParams(), &params.IncludeTotal)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_total: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostAuthPasswordReset(ctx)
+	err = w.Handler.GetAdminSecurityEvents(ctx, params)
 	return err
 }
 
-// PostAuthPasswordResetConfirm converts echo context to params.
-func (w *ServerInterfaceWrapper) PostAuthPasswordResetConfirm(ctx echo.Context) error {
+// GetAdminStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminStats(ctx echo.Context) error {
 	var err error
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostAuthPasswordResetConfirm(ctx)
-	return err
-}
-
-// PostAuthPasswordResetVerify converts echo context to params.
-func (w *ServerInterfaceWrapper) PostAuthPasswordResetVerify(ctx echo.Context) error {
-	var err error
+	ctx.Set(BearerAuthScopes, []string{"admin"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostAuthPasswordResetVerify(ctx)
+	err = w.Handler.GetAdminStats(ctx)
 	return err
 }
 
-// PostAuthRefreshToken converts echo context to params.
-func (w *ServerInterfaceWrapper) PostAuthRefreshToken(ctx echo.Context) error {
+// GetAdminUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminUsers(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostAuthRefreshTokenParams
+	var params GetAdminUsersParams
+	// ------------- Optional query parameter "q" -------------
 
-	if cookie, err := ctx.Cookie("refresh_token"); err == nil {
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
 
-		var value string
-		err = runtime.BindStyledParameterWithOptions("simple", "refresh_token", cookie.Value, &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationCookie, Explode: true, Required: false})
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter refresh_token: %s", err))
-		}
-		params.RefreshToken = &value
+	// ------------- Optional query parameter "role" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "role", ctx.QueryParams(), &params.Role)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostAuthRefreshToken(ctx, params)
-	return err
-}
+	// ------------- Optional query parameter "status" -------------
 
-// PostFocusSessions converts echo context to params.
-func (w *ServerInterfaceWrapper) PostFocusSessions(ctx echo.Context) error {
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", ctx.QueryParams(), &params.IncludeTotal)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_total: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostFocusSessions(ctx)
+	err = w.Handler.GetAdminUsers(ctx, params)
 	return err
 }
 
-// PostFocusSessionsIdEnd converts echo context to params.
-func (w *ServerInterfaceWrapper) PostFocusSessionsIdEnd(ctx echo.Context) error {
+// GetAdminUsersId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminUsersId(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
-	var id int32
+	var id UserIdParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(BearerAuthScopes, []string{"admin"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostFocusSessionsIdEnd(ctx, id)
+	err = w.Handler.GetAdminUsersId(ctx, id)
 	return err
 }
 
-// PostLogin converts echo context to params.
-func (w *ServerInterfaceWrapper) PostLogin(ctx echo.Context) error {
+// PostAdminUsersIdActivationEmail converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminUsersIdActivationEmail(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id UserIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostLogin(ctx)
+	err = w.Handler.PostAdminUsersIdActivationEmail(ctx, id)
 	return err
 }
 
-// PostLogout converts echo context to params.
-func (w *ServerInterfaceWrapper) PostLogout(ctx echo.Context) error {
+// PostAdminUsersIdDeactivate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminUsersIdDeactivate(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id UserIdParam
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PostLogoutParams
-
-	if cookie, err := ctx.Cookie("refresh_token"); err == nil {
-
-		var value string
-		err = runtime.BindStyledParameterWithOptions("simple", "refresh_token", cookie.Value, &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationCookie, Explode: true, Required: false})
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter refresh_token: %s", err))
-		}
-		params.RefreshToken = &value
-
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostLogout(ctx, params)
+	err = w.Handler.PostAdminUsersIdDeactivate(ctx, id)
 	return err
 }
 
-// GetProfile converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProfile(ctx echo.Context) error {
+// PostAdminUsersIdReactivate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminUsersIdReactivate(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id UserIdParam
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetProfile(ctx)
+	err = w.Handler.PostAdminUsersIdReactivate(ctx, id)
 	return err
 }
 
-// PostRegister converts echo context to params.
-func (w *ServerInterfaceWrapper) PostRegister(ctx echo.Context) error {
+// DeleteAdminUsersIdSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminUsersIdSessions(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id UserIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostRegister(ctx)
+	err = w.Handler.DeleteAdminUsersIdSessions(ctx, id)
 	return err
 }
 
-// GetTasks converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTasks(ctx echo.Context) error {
+// GetAnalyticsFocus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAnalyticsFocus(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTasksParams
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
-	}
-
-	// ------------- Optional query parameter "search" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
-	}
-
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
-	}
-
-	// ------------- Optional query parameter "priority" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "priority", ctx.QueryParams(), &params.Priority)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter priority: %s", err))
-	}
-
+	var params GetAnalyticsFocusParams
 	// ------------- Optional query parameter "start_date" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "start_date", ctx.QueryParams(), &params.StartDate)
@@ -357,1233 +494,5128 @@ func (w *ServerInterfaceWrapper) GetTasks(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_date: %s", err))
 	}
 
-	// ------------- Optional query parameter "sort_by" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sort_by", ctx.QueryParams(), &params.SortBy)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort_by: %s", err))
-	}
-
-	// ------------- Optional query parameter "sort_order" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sort_order", ctx.QueryParams(), &params.SortOrder)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort_order: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTasks(ctx, params)
+	err = w.Handler.GetAnalyticsFocus(ctx, params)
 	return err
 }
 
-// PostTasks converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTasks(ctx echo.Context) error {
+// PostAuthExchange converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthExchange(ctx echo.Context) error {
 	var err error
 
-	ctx.Set(BearerAuthScopes, []string{})
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostTasks(ctx)
+	err = w.Handler.PostAuthExchange(ctx)
 	return err
 }
 
-// DeleteTasksId converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTasksId(ctx echo.Context) error {
+// PostAuthMagicLink converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthMagicLink(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "id" -------------
-	var id int32
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthMagicLink(ctx)
+	return err
+}
 
-	ctx.Set(BearerAuthScopes, []string{})
+// PostAuthMagicLinkConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthMagicLinkConfirm(ctx echo.Context) error {
+	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTasksId(ctx, id)
+	err = w.Handler.PostAuthMagicLinkConfirm(ctx)
 	return err
 }
 
-// PutTasksId converts echo context to params.
-func (w *ServerInterfaceWrapper) PutTasksId(ctx echo.Context) error {
+// PostAuthPasskeyLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthPasskeyLogin(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "id" -------------
-	var id int32
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthPasskeyLogin(ctx)
+	return err
+}
 
-	ctx.Set(BearerAuthScopes, []string{})
+// PostAuthPasskeyOptions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthPasskeyOptions(ctx echo.Context) error {
+	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutTasksId(ctx, id)
+	err = w.Handler.PostAuthPasskeyOptions(ctx)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+// PostAuthPasswordReset converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthPasswordReset(ctx echo.Context) error {
+	var err error
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthPasswordReset(ctx)
+	return err
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// PostAuthPasswordResetConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthPasswordResetConfirm(ctx echo.Context) error {
+	var err error
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthPasswordResetConfirm(ctx)
+	return err
+}
 
-	router.POST(baseURL+"/activation", wrapper.PostActivation)
-	router.POST(baseURL+"/activation/email", wrapper.PostActivationEmail)
-	router.GET(baseURL+"/analytics/focus", wrapper.GetAnalyticsFocus)
-	router.GET(baseURL+"/auth/google/authorize", wrapper.GetAuthGoogleAuthorize)
-	router.GET(baseURL+"/auth/google/callback", wrapper.GetAuthGoogleCallback)
-	router.POST(baseURL+"/auth/password-reset", wrapper.PostAuthPasswordReset)
-	router.POST(baseURL+"/auth/password-reset/confirm", wrapper.PostAuthPasswordResetConfirm)
-	router.POST(baseURL+"/auth/password-reset/verify", wrapper.PostAuthPasswordResetVerify)
-	router.POST(baseURL+"/auth/refresh-token", wrapper.PostAuthRefreshToken)
-	router.POST(baseURL+"/focus-sessions", wrapper.PostFocusSessions)
-	router.POST(baseURL+"/focus-sessions/:id/end", wrapper.PostFocusSessionsIdEnd)
-	router.POST(baseURL+"/login", wrapper.PostLogin)
-	router.POST(baseURL+"/logout", wrapper.PostLogout)
-	router.GET(baseURL+"/profile", wrapper.GetProfile)
-	router.POST(baseURL+"/register", wrapper.PostRegister)
-	router.GET(baseURL+"/tasks", wrapper.GetTasks)
-	router.POST(baseURL+"/tasks", wrapper.PostTasks)
-	router.DELETE(baseURL+"/tasks/:id", wrapper.DeleteTasksId)
-	router.PUT(baseURL+"/tasks/:id", wrapper.PutTasksId)
+// PostAuthPasswordResetVerify converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthPasswordResetVerify(ctx echo.Context) error {
+	var err error
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthPasswordResetVerify(ctx)
+	return err
 }
 
-type ForbiddenJSONResponse DefaultResponse
+// PostAuthRefreshToken converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthRefreshToken(ctx echo.Context) error {
+	var err error
 
-type TokenErrorJSONResponse TokenError
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuthRefreshTokenParams
 
-type PostActivationRequestObject struct {
-	Body *PostActivationJSONRequestBody
-}
+	if cookie, err := ctx.Cookie("refresh_token"); err == nil {
 
-type PostActivationResponseObject interface {
-	VisitPostActivationResponse(w http.ResponseWriter) error
-}
+		var value string
+		err = runtime.BindStyledParameterWithOptions("simple", "refresh_token", cookie.Value, &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationCookie, Explode: true, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter refresh_token: %s", err))
+		}
+		params.RefreshToken = &value
 
-type PostActivation200Response struct {
-}
+	}
 
-func (response PostActivation200Response) VisitPostActivationResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthRefreshToken(ctx, params)
+	return err
 }
 
-type PostActivation403JSONResponse struct{ TokenErrorJSONResponse }
+// GetAuthProviderAuthorize converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuthProviderAuthorize(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "provider" -------------
+	var provider ProviderParam
 
-func (response PostActivation403JSONResponse) VisitPostActivationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", ctx.Param("provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter provider: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthProviderAuthorizeParams
+	// ------------- Optional query parameter "mode" -------------
 
-type PostActivationEmailRequestObject struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "mode", ctx.QueryParams(), &params.Mode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mode: %s", err))
+	}
 
-type PostActivationEmailResponseObject interface {
-	VisitPostActivationEmailResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "return_to" -------------
 
-type PostActivationEmail200Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "return_to", ctx.QueryParams(), &params.ReturnTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter return_to: %s", err))
+	}
 
-func (response PostActivationEmail200Response) VisitPostActivationEmailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// ------------- Optional query parameter "code_challenge" -------------
 
-type PostActivationEmail400Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "code_challenge", ctx.QueryParams(), &params.CodeChallenge)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code_challenge: %s", err))
+	}
 
-func (response PostActivationEmail400Response) VisitPostActivationEmailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Sec-Fetch-Mode" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Sec-Fetch-Mode")]; found {
+		var SecFetchMode string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Sec-Fetch-Mode, got %d", n))
+		}
 
-type PostActivationEmail403JSONResponse struct{ ForbiddenJSONResponse }
+		err = runtime.BindStyledParameterWithOptions("simple", "Sec-Fetch-Mode", valueList[0], &SecFetchMode, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Sec-Fetch-Mode: %s", err))
+		}
 
-func (response PostActivationEmail403JSONResponse) VisitPostActivationEmailResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+		params.SecFetchMode = &SecFetchMode
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuthProviderAuthorize(ctx, provider, params)
+	return err
 }
 
-type GetAnalyticsFocusRequestObject struct {
-	Params GetAnalyticsFocusParams
-}
+// GetAuthProviderCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuthProviderCallback(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "provider" -------------
+	var provider ProviderParam
 
-type GetAnalyticsFocusResponseObject interface {
-	VisitGetAnalyticsFocusResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", ctx.Param("provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter provider: %s", err))
+	}
 
-type GetAnalyticsFocus200JSONResponse FocusAnalytics
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthProviderCallbackParams
+	// ------------- Optional query parameter "code" -------------
 
-func (response GetAnalyticsFocus200JSONResponse) VisitGetAnalyticsFocusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "code", ctx.QueryParams(), &params.Code)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Required query parameter "state" -------------
 
-type GetAnalyticsFocus403JSONResponse struct{ ForbiddenJSONResponse }
+	err = runtime.BindQueryParameter("form", true, true, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+	}
 
-func (response GetAnalyticsFocus403JSONResponse) VisitGetAnalyticsFocusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// ------------- Optional query parameter "error" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "error", ctx.QueryParams(), &params.Error)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter error: %s", err))
+	}
 
-type GetAuthGoogleAuthorizeRequestObject struct {
-}
+	if cookie, err := ctx.Cookie("auth_binding"); err == nil {
 
-type GetAuthGoogleAuthorizeResponseObject interface {
-	VisitGetAuthGoogleAuthorizeResponse(w http.ResponseWriter) error
-}
+		var value string
+		err = runtime.BindStyledParameterWithOptions("simple", "auth_binding", cookie.Value, &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationCookie, Explode: true, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter auth_binding: %s", err))
+		}
+		params.AuthBinding = &value
 
-type GetAuthGoogleAuthorize303ResponseHeaders struct {
-	Location  string
-	SetCookie string
-}
+	}
 
-type GetAuthGoogleAuthorize303Response struct {
-	Headers GetAuthGoogleAuthorize303ResponseHeaders
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuthProviderCallback(ctx, provider, params)
+	return err
 }
 
-func (response GetAuthGoogleAuthorize303Response) VisitGetAuthGoogleAuthorizeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(303)
-	return nil
-}
+// GetFocusSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFocusSessions(ctx echo.Context) error {
+	var err error
 
-type GetAuthGoogleCallbackRequestObject struct {
-	Params GetAuthGoogleCallbackParams
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetAuthGoogleCallbackResponseObject interface {
-	VisitGetAuthGoogleCallbackResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFocusSessionsParams
+	// ------------- Optional query parameter "page" -------------
 
-type GetAuthGoogleCallback200TexthtmlResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
 
-func (response GetAuthGoogleCallback200TexthtmlResponse) VisitGetAuthGoogleCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/html")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
-	w.WriteHeader(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
-	_, err := io.Copy(w, response.Body)
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", ctx.QueryParams(), &params.IncludeTotal)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_total: %s", err))
+	}
+
+	// ------------- Optional query parameter "task_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "task_id", ctx.QueryParams(), &params.TaskId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter task_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFocusSessions(ctx, params)
 	return err
 }
 
-type GetAuthGoogleCallback301ResponseHeaders struct {
-	Location string
-}
+// PostFocusSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFocusSessions(ctx echo.Context) error {
+	var err error
 
-type GetAuthGoogleCallback301Response struct {
-	Headers GetAuthGoogleCallback301ResponseHeaders
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-func (response GetAuthGoogleCallback301Response) VisitGetAuthGoogleCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(301)
-	return nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostFocusSessions(ctx)
+	return err
 }
 
-type GetAuthGoogleCallback400JSONResponse DefaultResponse
+// PostFocusSessionsIdEnd converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFocusSessionsIdEnd(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
 
-func (response GetAuthGoogleCallback400JSONResponse) VisitGetAuthGoogleCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostAuthPasswordResetRequestObject struct {
-	Body *PostAuthPasswordResetJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostFocusSessionsIdEnd(ctx, id)
+	return err
 }
 
-type PostAuthPasswordResetResponseObject interface {
-	VisitPostAuthPasswordResetResponse(w http.ResponseWriter) error
-}
+// PostLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLogin(ctx echo.Context) error {
+	var err error
 
-type PostAuthPasswordReset200Response struct {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLogin(ctx)
+	return err
 }
 
-func (response PostAuthPasswordReset200Response) VisitPostAuthPasswordResetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
-
-type PostAuthPasswordReset400Response struct {
-}
+// PostLogin2fa converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLogin2fa(ctx echo.Context) error {
+	var err error
 
-func (response PostAuthPasswordReset400Response) VisitPostAuthPasswordResetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLogin2fa(ctx)
+	return err
 }
 
-type PostAuthPasswordResetConfirmRequestObject struct {
-	Body *PostAuthPasswordResetConfirmJSONRequestBody
-}
+// PostLogout converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLogout(ctx echo.Context) error {
+	var err error
 
-type PostAuthPasswordResetConfirmResponseObject interface {
-	VisitPostAuthPasswordResetConfirmResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostLogoutParams
 
-type PostAuthPasswordResetConfirm200Response struct {
-}
+	if cookie, err := ctx.Cookie("refresh_token"); err == nil {
 
-func (response PostAuthPasswordResetConfirm200Response) VisitPostAuthPasswordResetConfirmResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+		var value string
+		err = runtime.BindStyledParameterWithOptions("simple", "refresh_token", cookie.Value, &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationCookie, Explode: true, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter refresh_token: %s", err))
+		}
+		params.RefreshToken = &value
 
-type PostAuthPasswordResetConfirm400Response struct {
-}
+	}
 
-func (response PostAuthPasswordResetConfirm400Response) VisitPostAuthPasswordResetConfirmResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLogout(ctx, params)
+	return err
 }
 
-type PostAuthPasswordResetConfirm403JSONResponse struct{ TokenErrorJSONResponse }
-
-func (response PostAuthPasswordResetConfirm403JSONResponse) VisitPostAuthPasswordResetConfirmResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// DeleteProfile converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProfile(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostAuthPasswordResetVerifyRequestObject struct {
-	Body *PostAuthPasswordResetVerifyJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProfile(ctx)
+	return err
 }
 
-type PostAuthPasswordResetVerifyResponseObject interface {
-	VisitPostAuthPasswordResetVerifyResponse(w http.ResponseWriter) error
-}
+// GetProfile converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfile(ctx echo.Context) error {
+	var err error
 
-type PostAuthPasswordResetVerify200Response struct {
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-func (response PostAuthPasswordResetVerify200Response) VisitPostAuthPasswordResetVerifyResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProfile(ctx)
+	return err
 }
 
-type PostAuthPasswordResetVerify403JSONResponse struct{ TokenErrorJSONResponse }
-
-func (response PostAuthPasswordResetVerify403JSONResponse) VisitPostAuthPasswordResetVerifyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// PatchProfile converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProfile(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostAuthRefreshTokenRequestObject struct {
-	Params PostAuthRefreshTokenParams
-	Body   *PostAuthRefreshTokenJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchProfile(ctx)
+	return err
 }
 
-type PostAuthRefreshTokenResponseObject interface {
-	VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error
-}
+// PostProfile2faDisable converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfile2faDisable(ctx echo.Context) error {
+	var err error
 
-type PostAuthRefreshToken200ResponseHeaders struct {
-	SetCookie string
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostAuthRefreshToken200JSONResponse struct {
-	Body    AuthTokens
-	Headers PostAuthRefreshToken200ResponseHeaders
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfile2faDisable(ctx)
+	return err
 }
 
-func (response PostAuthRefreshToken200JSONResponse) VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+// PostProfile2faTotp converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfile2faTotp(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostAuthRefreshToken403Response struct {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfile2faTotp(ctx)
+	return err
 }
 
-func (response PostAuthRefreshToken403Response) VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+// PostProfile2faTotpConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfile2faTotpConfirm(ctx echo.Context) error {
+	var err error
 
-type PostFocusSessionsRequestObject struct {
-	Body *PostFocusSessionsJSONRequestBody
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostFocusSessionsResponseObject interface {
-	VisitPostFocusSessionsResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfile2faTotpConfirm(ctx)
+	return err
 }
 
-type PostFocusSessions201JSONResponse FocusSession
+// PostProfileEmail converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileEmail(ctx echo.Context) error {
+	var err error
 
-func (response PostFocusSessions201JSONResponse) VisitPostFocusSessionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileEmail(ctx)
+	return err
 }
 
-type PostFocusSessions400Response struct {
-}
+// PostProfileEmailConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileEmailConfirm(ctx echo.Context) error {
+	var err error
 
-func (response PostFocusSessions400Response) VisitPostFocusSessionsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileEmailConfirm(ctx)
+	return err
 }
 
-type PostFocusSessions403JSONResponse struct{ ForbiddenJSONResponse }
+// PostProfileIdentities converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfileIdentities(ctx echo.Context) error {
+	var err error
 
-func (response PostFocusSessions403JSONResponse) VisitPostFocusSessionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfileIdentities(ctx)
+	return err
 }
 
-type PostFocusSessions404Response struct {
-}
+// DeleteProfileIdentitiesId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProfileIdentitiesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
 
-func (response PostFocusSessions404Response) VisitPostFocusSessionsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
-type PostFocusSessionsIdEndRequestObject struct {
-	Id   int32 `json:"id"`
-	Body *PostFocusSessionsIdEndJSONRequestBody
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostFocusSessionsIdEndResponseObject interface {
-	VisitPostFocusSessionsIdEndResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProfileIdentitiesId(ctx, id)
+	return err
 }
 
-type PostFocusSessionsIdEnd200JSONResponse FocusSession
+// GetProfilePasskeys converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfilePasskeys(ctx echo.Context) error {
+	var err error
 
-func (response PostFocusSessionsIdEnd200JSONResponse) VisitPostFocusSessionsIdEndResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProfilePasskeys(ctx)
+	return err
 }
 
-type PostFocusSessionsIdEnd400Response struct {
-}
+// PostProfilePasskeys converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfilePasskeys(ctx echo.Context) error {
+	var err error
 
-func (response PostFocusSessionsIdEnd400Response) VisitPostFocusSessionsIdEndResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfilePasskeys(ctx)
+	return err
 }
 
-type PostFocusSessionsIdEnd403JSONResponse struct{ ForbiddenJSONResponse }
+// PostProfilePasskeysOptions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfilePasskeysOptions(ctx echo.Context) error {
+	var err error
 
-func (response PostFocusSessionsIdEnd403JSONResponse) VisitPostFocusSessionsIdEndResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfilePasskeysOptions(ctx)
+	return err
 }
 
-type PostFocusSessionsIdEnd404Response struct {
-}
+// DeleteProfilePasskeysId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProfilePasskeysId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
 
-func (response PostFocusSessionsIdEnd404Response) VisitPostFocusSessionsIdEndResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
-type PostLoginRequestObject struct {
-	Body *PostLoginJSONRequestBody
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostLoginResponseObject interface {
-	VisitPostLoginResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProfilePasskeysId(ctx, id)
+	return err
 }
 
-type PostLogin200ResponseHeaders struct {
-	SetCookie string
-}
+// PostProfilePassword converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProfilePassword(ctx echo.Context) error {
+	var err error
 
-type PostLogin200JSONResponse struct {
-	Body struct {
-		AccessToken *string `json:"access_token,omitempty"`
+	ctx.Set(BearerAuthScopes, []string{})
 
-		// IsActivated Whether the user's email is activated
-		IsActivated  *bool   `json:"is_activated,omitempty"`
-		RefreshToken *string `json:"refresh_token,omitempty"`
-	}
-	Headers PostLogin200ResponseHeaders
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProfilePassword(ctx)
+	return err
 }
 
-func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+// GetProfileSecurityEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileSecurityEvents(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostLogin400Response struct {
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileSecurityEventsParams
+	// ------------- Optional query parameter "type" -------------
 
-func (response PostLogin400Response) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
 
-type PostLogoutRequestObject struct {
-	Params PostLogoutParams
-	Body   *PostLogoutJSONRequestBody
-}
+	// ------------- Optional query parameter "page" -------------
 
-type PostLogoutResponseObject interface {
-	VisitPostLogoutResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
 
-type PostLogout200ResponseHeaders struct {
-	SetCookie string
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type PostLogout200Response struct {
-	Headers PostLogout200ResponseHeaders
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-func (response PostLogout200Response) VisitPostLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
-	return nil
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-type PostLogout403ResponseHeaders struct {
-	SetCookie string
-}
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
 
-type PostLogout403Response struct {
-	Headers PostLogout403ResponseHeaders
-}
+	// ------------- Optional query parameter "include_total" -------------
 
-func (response PostLogout403Response) VisitPostLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(403)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "include_total", ctx.QueryParams(), &params.IncludeTotal)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_total: %s", err))
+	}
 
-type GetProfileRequestObject struct {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProfileSecurityEvents(ctx, params)
+	return err
 }
 
-type GetProfileResponseObject interface {
-	VisitGetProfileResponse(w http.ResponseWriter) error
+// PostRegister converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRegister(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRegister(ctx)
+	return err
 }
 
-type GetProfile200JSONResponse User
+// GetSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSessions(ctx echo.Context) error {
+	var err error
 
-func (response GetProfile200JSONResponse) VisitGetProfileResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSessions(ctx)
+	return err
 }
 
-type GetProfile403JSONResponse struct{ ForbiddenJSONResponse }
-
-func (response GetProfile403JSONResponse) VisitGetProfileResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// PostSessionsLogoutOthers converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessionsLogoutOthers(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostRegisterRequestObject struct {
-	Body *PostRegisterJSONRequestBody
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSessionsLogoutOthersParams
 
-type PostRegisterResponseObject interface {
-	VisitPostRegisterResponse(w http.ResponseWriter) error
-}
+	if cookie, err := ctx.Cookie("refresh_token"); err == nil {
 
-type PostRegister201JSONResponse AuthTokens
+		var value string
+		err = runtime.BindStyledParameterWithOptions("simple", "refresh_token", cookie.Value, &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationCookie, Explode: true, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter refresh_token: %s", err))
+		}
+		params.RefreshToken = &value
 
-func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessionsLogoutOthers(ctx, params)
+	return err
 }
 
-type PostRegister400JSONResponse RegisterError
-
-func (response PostRegister400JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// DeleteSessionsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSessionsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
-type GetTasksRequestObject struct {
-	Params GetTasksParams
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetTasksResponseObject interface {
-	VisitGetTasksResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteSessionsId(ctx, id)
+	return err
 }
 
-type GetTasks200JSONResponse struct {
-	Data       *[]Task             `json:"data,omitempty"`
-	Pagination *PaginationResponse `json:"pagination,omitempty"`
-}
+// GetTaskViews converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskViews(ctx echo.Context) error {
+	var err error
 
-func (response GetTasks200JSONResponse) VisitGetTasksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskViews(ctx)
+	return err
 }
 
-type GetTasks400Response struct {
-}
+// PostTaskViews converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTaskViews(ctx echo.Context) error {
+	var err error
 
-func (response GetTasks400Response) VisitGetTasksResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTaskViews(ctx)
+	return err
 }
 
-type GetTasks403JSONResponse struct{ ForbiddenJSONResponse }
+// DeleteTaskViewsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTaskViewsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
 
-func (response GetTasks403JSONResponse) VisitGetTasksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostTasksRequestObject struct {
-	Body *PostTasksJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTaskViewsId(ctx, id)
+	return err
 }
 
-type PostTasksResponseObject interface {
-	VisitPostTasksResponse(w http.ResponseWriter) error
-}
+// GetTaskViewsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskViewsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
 
-type PostTasks201JSONResponse Task
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
-func (response PostTasks201JSONResponse) VisitPostTasksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskViewsId(ctx, id)
+	return err
 }
 
-type PostTasks400JSONResponse DefaultResponse
+// PutTaskViewsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTaskViewsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
 
-func (response PostTasks400JSONResponse) VisitPostTasksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostTasks403JSONResponse struct{ ForbiddenJSONResponse }
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutTaskViewsId(ctx, id)
+	return err
+}
 
-func (response PostTasks403JSONResponse) VisitPostTasksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// GetTaskViewsIdTasks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTaskViewsIdTasks(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
-type DeleteTasksIdRequestObject struct {
-	Id int32 `json:"id"`
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeleteTasksIdResponseObject interface {
-	VisitDeleteTasksIdResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskViewsIdTasksParams
+	// ------------- Optional query parameter "page" -------------
 
-type DeleteTasksId204Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
 
-func (response DeleteTasksId204Response) VisitDeleteTasksIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type DeleteTasksId403JSONResponse struct{ ForbiddenJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-func (response DeleteTasksId403JSONResponse) VisitDeleteTasksIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// ------------- Optional query parameter "cursor" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
 
-type DeleteTasksId404JSONResponse DefaultResponse
+	// ------------- Optional query parameter "include_total" -------------
 
-func (response DeleteTasksId404JSONResponse) VisitDeleteTasksIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "include_total", ctx.QueryParams(), &params.IncludeTotal)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_total: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTaskViewsIdTasks(ctx, id, params)
+	return err
 }
 
-type PutTasksIdRequestObject struct {
-	Id   int32 `json:"id"`
-	Body *PutTasksIdJSONRequestBody
-}
+// GetTasks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasks(ctx echo.Context) error {
+	var err error
 
-type PutTasksIdResponseObject interface {
-	VisitPutTasksIdResponse(w http.ResponseWriter) error
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PutTasksId200Response struct {
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTasksParams
+	// ------------- Optional query parameter "page" -------------
 
-func (response PutTasksId200Response) VisitPutTasksIdResponse(w http.ResponseWriter) error {
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", ctx.QueryParams(), &params.IncludeTotal)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_total: %s", err))
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "exclude_status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_status", ctx.QueryParams(), &params.ExcludeStatus)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude_status: %s", err))
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", ctx.QueryParams(), &params.Priority)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter priority: %s", err))
+	}
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date", ctx.QueryParams(), &params.StartDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", ctx.QueryParams(), &params.EndDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_by", ctx.QueryParams(), &params.SortBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort_by: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", ctx.QueryParams(), &params.SortOrder)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort_order: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasks(ctx, params)
+	return err
+}
+
+// PostTasks converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTasks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTasks(ctx)
+	return err
+}
+
+// PostTasksBatch converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTasksBatch(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTasksBatch(ctx)
+	return err
+}
+
+// GetTasksTrash converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasksTrash(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTasksTrashParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", ctx.QueryParams(), &params.IncludeTotal)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_total: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasksTrash(ctx, params)
+	return err
+}
+
+// DeleteTasksId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTasksId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTasksId(ctx, id)
+	return err
+}
+
+// PutTasksId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTasksId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutTasksId(ctx, id)
+	return err
+}
+
+// PostTasksIdRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTasksIdRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTasksIdRestore(ctx, id)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/.well-known/jwks.json", wrapper.GetWellKnownJwksJson)
+	router.POST(baseURL+"/activation", wrapper.PostActivation)
+	router.POST(baseURL+"/activation/email", wrapper.PostActivationEmail)
+	router.GET(baseURL+"/admin/security-events", wrapper.GetAdminSecurityEvents)
+	router.GET(baseURL+"/admin/stats", wrapper.GetAdminStats)
+	router.GET(baseURL+"/admin/users", wrapper.GetAdminUsers)
+	router.GET(baseURL+"/admin/users/:id", wrapper.GetAdminUsersId)
+	router.POST(baseURL+"/admin/users/:id/activation-email", wrapper.PostAdminUsersIdActivationEmail)
+	router.POST(baseURL+"/admin/users/:id/deactivate", wrapper.PostAdminUsersIdDeactivate)
+	router.POST(baseURL+"/admin/users/:id/reactivate", wrapper.PostAdminUsersIdReactivate)
+	router.DELETE(baseURL+"/admin/users/:id/sessions", wrapper.DeleteAdminUsersIdSessions)
+	router.GET(baseURL+"/analytics/focus", wrapper.GetAnalyticsFocus)
+	router.POST(baseURL+"/auth/exchange", wrapper.PostAuthExchange)
+	router.POST(baseURL+"/auth/magic-link", wrapper.PostAuthMagicLink)
+	router.POST(baseURL+"/auth/magic-link/confirm", wrapper.PostAuthMagicLinkConfirm)
+	router.POST(baseURL+"/auth/passkey/login", wrapper.PostAuthPasskeyLogin)
+	router.POST(baseURL+"/auth/passkey/options", wrapper.PostAuthPasskeyOptions)
+	router.POST(baseURL+"/auth/password-reset", wrapper.PostAuthPasswordReset)
+	router.POST(baseURL+"/auth/password-reset/confirm", wrapper.PostAuthPasswordResetConfirm)
+	router.POST(baseURL+"/auth/password-reset/verify", wrapper.PostAuthPasswordResetVerify)
+	router.POST(baseURL+"/auth/refresh-token", wrapper.PostAuthRefreshToken)
+	router.GET(baseURL+"/auth/:provider/authorize", wrapper.GetAuthProviderAuthorize)
+	router.GET(baseURL+"/auth/:provider/callback", wrapper.GetAuthProviderCallback)
+	router.GET(baseURL+"/focus-sessions", wrapper.GetFocusSessions)
+	router.POST(baseURL+"/focus-sessions", wrapper.PostFocusSessions)
+	router.POST(baseURL+"/focus-sessions/:id/end", wrapper.PostFocusSessionsIdEnd)
+	router.POST(baseURL+"/login", wrapper.PostLogin)
+	router.POST(baseURL+"/login/2fa", wrapper.PostLogin2fa)
+	router.POST(baseURL+"/logout", wrapper.PostLogout)
+	router.DELETE(baseURL+"/profile", wrapper.DeleteProfile)
+	router.GET(baseURL+"/profile", wrapper.GetProfile)
+	router.PATCH(baseURL+"/profile", wrapper.PatchProfile)
+	router.POST(baseURL+"/profile/2fa/disable", wrapper.PostProfile2faDisable)
+	router.POST(baseURL+"/profile/2fa/totp", wrapper.PostProfile2faTotp)
+	router.POST(baseURL+"/profile/2fa/totp/confirm", wrapper.PostProfile2faTotpConfirm)
+	router.POST(baseURL+"/profile/email", wrapper.PostProfileEmail)
+	router.POST(baseURL+"/profile/email/confirm", wrapper.PostProfileEmailConfirm)
+	router.POST(baseURL+"/profile/identities", wrapper.PostProfileIdentities)
+	router.DELETE(baseURL+"/profile/identities/:id", wrapper.DeleteProfileIdentitiesId)
+	router.GET(baseURL+"/profile/passkeys", wrapper.GetProfilePasskeys)
+	router.POST(baseURL+"/profile/passkeys", wrapper.PostProfilePasskeys)
+	router.POST(baseURL+"/profile/passkeys/options", wrapper.PostProfilePasskeysOptions)
+	router.DELETE(baseURL+"/profile/passkeys/:id", wrapper.DeleteProfilePasskeysId)
+	router.POST(baseURL+"/profile/password", wrapper.PostProfilePassword)
+	router.GET(baseURL+"/profile/security-events", wrapper.GetProfileSecurityEvents)
+	router.POST(baseURL+"/register", wrapper.PostRegister)
+	router.GET(baseURL+"/sessions", wrapper.GetSessions)
+	router.POST(baseURL+"/sessions/logout-others", wrapper.PostSessionsLogoutOthers)
+	router.DELETE(baseURL+"/sessions/:id", wrapper.DeleteSessionsId)
+	router.GET(baseURL+"/task-views", wrapper.GetTaskViews)
+	router.POST(baseURL+"/task-views", wrapper.PostTaskViews)
+	router.DELETE(baseURL+"/task-views/:id", wrapper.DeleteTaskViewsId)
+	router.GET(baseURL+"/task-views/:id", wrapper.GetTaskViewsId)
+	router.PUT(baseURL+"/task-views/:id", wrapper.PutTaskViewsId)
+	router.GET(baseURL+"/task-views/:id/tasks", wrapper.GetTaskViewsIdTasks)
+	router.GET(baseURL+"/tasks", wrapper.GetTasks)
+	router.POST(baseURL+"/tasks", wrapper.PostTasks)
+	router.POST(baseURL+"/tasks/batch", wrapper.PostTasksBatch)
+	router.GET(baseURL+"/tasks/trash", wrapper.GetTasksTrash)
+	router.DELETE(baseURL+"/tasks/:id", wrapper.DeleteTasksId)
+	router.PUT(baseURL+"/tasks/:id", wrapper.PutTasksId)
+	router.POST(baseURL+"/tasks/:id/restore", wrapper.PostTasksIdRestore)
+
+}
+
+type AccountDisabledJSONResponse DefaultResponse
+
+type ForbiddenJSONResponse DefaultResponse
+
+type TokenErrorJSONResponse TokenError
+
+type TooManyRequestsResponseHeaders struct {
+	RetryAfter int
+}
+type TooManyRequestsJSONResponse struct {
+	Body DefaultResponse
+
+	Headers TooManyRequestsResponseHeaders
+}
+
+type GetWellKnownJwksJsonRequestObject struct {
+}
+
+type GetWellKnownJwksJsonResponseObject interface {
+	VisitGetWellKnownJwksJsonResponse(w http.ResponseWriter) error
+}
+
+type GetWellKnownJwksJson200JSONResponse JsonWebKeySet
+
+func (response GetWellKnownJwksJson200JSONResponse) VisitGetWellKnownJwksJsonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostActivationRequestObject struct {
+	Body *PostActivationJSONRequestBody
+}
+
+type PostActivationResponseObject interface {
+	VisitPostActivationResponse(w http.ResponseWriter) error
+}
+
+type PostActivation200Response struct {
+}
+
+func (response PostActivation200Response) VisitPostActivationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostActivation403JSONResponse struct{ TokenErrorJSONResponse }
+
+func (response PostActivation403JSONResponse) VisitPostActivationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostActivationEmailRequestObject struct {
+}
+
+type PostActivationEmailResponseObject interface {
+	VisitPostActivationEmailResponse(w http.ResponseWriter) error
+}
+
+type PostActivationEmail200Response struct {
+}
+
+func (response PostActivationEmail200Response) VisitPostActivationEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostActivationEmail400Response struct {
+}
+
+func (response PostActivationEmail400Response) VisitPostActivationEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PostActivationEmail403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostActivationEmail403JSONResponse) VisitPostActivationEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostActivationEmail429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostActivationEmail429JSONResponse) VisitPostActivationEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAdminSecurityEventsRequestObject struct {
+	Params GetAdminSecurityEventsParams
+}
+
+type GetAdminSecurityEventsResponseObject interface {
+	VisitGetAdminSecurityEventsResponse(w http.ResponseWriter) error
+}
+
+type GetAdminSecurityEvents200JSONResponse struct {
+	Data       *[]SecurityEvent    `json:"data,omitempty"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+}
+
+func (response GetAdminSecurityEvents200JSONResponse) VisitGetAdminSecurityEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminSecurityEvents400JSONResponse DefaultResponse
+
+func (response GetAdminSecurityEvents400JSONResponse) VisitGetAdminSecurityEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminSecurityEvents403JSONResponse DefaultResponse
+
+func (response GetAdminSecurityEvents403JSONResponse) VisitGetAdminSecurityEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminStatsRequestObject struct {
+}
+
+type GetAdminStatsResponseObject interface {
+	VisitGetAdminStatsResponse(w http.ResponseWriter) error
+}
+
+type GetAdminStats200JSONResponse AdminStats
+
+func (response GetAdminStats200JSONResponse) VisitGetAdminStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminStats403JSONResponse DefaultResponse
+
+func (response GetAdminStats403JSONResponse) VisitGetAdminStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminUsersRequestObject struct {
+	Params GetAdminUsersParams
+}
+
+type GetAdminUsersResponseObject interface {
+	VisitGetAdminUsersResponse(w http.ResponseWriter) error
+}
+
+type GetAdminUsers200JSONResponse struct {
+	Data       *[]AdminUser        `json:"data,omitempty"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+}
+
+func (response GetAdminUsers200JSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminUsers400JSONResponse DefaultResponse
+
+func (response GetAdminUsers400JSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminUsers403JSONResponse DefaultResponse
+
+func (response GetAdminUsers403JSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminUsersIdRequestObject struct {
+	Id UserIdParam `json:"id"`
+}
+
+type GetAdminUsersIdResponseObject interface {
+	VisitGetAdminUsersIdResponse(w http.ResponseWriter) error
+}
+
+type GetAdminUsersId200JSONResponse AdminUser
+
+func (response GetAdminUsersId200JSONResponse) VisitGetAdminUsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminUsersId403JSONResponse DefaultResponse
+
+func (response GetAdminUsersId403JSONResponse) VisitGetAdminUsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminUsersId404JSONResponse DefaultResponse
+
+func (response GetAdminUsersId404JSONResponse) VisitGetAdminUsersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersIdActivationEmailRequestObject struct {
+	Id UserIdParam `json:"id"`
+}
+
+type PostAdminUsersIdActivationEmailResponseObject interface {
+	VisitPostAdminUsersIdActivationEmailResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersIdActivationEmail204Response struct {
+}
+
+func (response PostAdminUsersIdActivationEmail204Response) VisitPostAdminUsersIdActivationEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostAdminUsersIdActivationEmail403JSONResponse DefaultResponse
+
+func (response PostAdminUsersIdActivationEmail403JSONResponse) VisitPostAdminUsersIdActivationEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersIdActivationEmail404JSONResponse DefaultResponse
+
+func (response PostAdminUsersIdActivationEmail404JSONResponse) VisitPostAdminUsersIdActivationEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersIdActivationEmail409JSONResponse DefaultResponse
+
+func (response PostAdminUsersIdActivationEmail409JSONResponse) VisitPostAdminUsersIdActivationEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersIdActivationEmail429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostAdminUsersIdActivationEmail429JSONResponse) VisitPostAdminUsersIdActivationEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAdminUsersIdDeactivateRequestObject struct {
+	Id UserIdParam `json:"id"`
+}
+
+type PostAdminUsersIdDeactivateResponseObject interface {
+	VisitPostAdminUsersIdDeactivateResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersIdDeactivate200JSONResponse AdminUser
+
+func (response PostAdminUsersIdDeactivate200JSONResponse) VisitPostAdminUsersIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersIdDeactivate403JSONResponse DefaultResponse
+
+func (response PostAdminUsersIdDeactivate403JSONResponse) VisitPostAdminUsersIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersIdDeactivate404JSONResponse DefaultResponse
+
+func (response PostAdminUsersIdDeactivate404JSONResponse) VisitPostAdminUsersIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersIdDeactivate409JSONResponse DefaultResponse
+
+func (response PostAdminUsersIdDeactivate409JSONResponse) VisitPostAdminUsersIdDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersIdReactivateRequestObject struct {
+	Id UserIdParam `json:"id"`
+}
+
+type PostAdminUsersIdReactivateResponseObject interface {
+	VisitPostAdminUsersIdReactivateResponse(w http.ResponseWriter) error
+}
+
+type PostAdminUsersIdReactivate200JSONResponse AdminUser
+
+func (response PostAdminUsersIdReactivate200JSONResponse) VisitPostAdminUsersIdReactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersIdReactivate403JSONResponse DefaultResponse
+
+func (response PostAdminUsersIdReactivate403JSONResponse) VisitPostAdminUsersIdReactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminUsersIdReactivate404JSONResponse DefaultResponse
+
+func (response PostAdminUsersIdReactivate404JSONResponse) VisitPostAdminUsersIdReactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminUsersIdSessionsRequestObject struct {
+	Id UserIdParam `json:"id"`
+}
+
+type DeleteAdminUsersIdSessionsResponseObject interface {
+	VisitDeleteAdminUsersIdSessionsResponse(w http.ResponseWriter) error
+}
+
+type DeleteAdminUsersIdSessions204Response struct {
+}
+
+func (response DeleteAdminUsersIdSessions204Response) VisitDeleteAdminUsersIdSessionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAdminUsersIdSessions403JSONResponse DefaultResponse
+
+func (response DeleteAdminUsersIdSessions403JSONResponse) VisitDeleteAdminUsersIdSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminUsersIdSessions404JSONResponse DefaultResponse
+
+func (response DeleteAdminUsersIdSessions404JSONResponse) VisitDeleteAdminUsersIdSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsFocusRequestObject struct {
+	Params GetAnalyticsFocusParams
+}
+
+type GetAnalyticsFocusResponseObject interface {
+	VisitGetAnalyticsFocusResponse(w http.ResponseWriter) error
+}
+
+type GetAnalyticsFocus200JSONResponse FocusAnalytics
+
+func (response GetAnalyticsFocus200JSONResponse) VisitGetAnalyticsFocusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsFocus403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetAnalyticsFocus403JSONResponse) VisitGetAnalyticsFocusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthExchangeRequestObject struct {
+	Body *PostAuthExchangeJSONRequestBody
+}
+
+type PostAuthExchangeResponseObject interface {
+	VisitPostAuthExchangeResponse(w http.ResponseWriter) error
+}
+
+type PostAuthExchange200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PostAuthExchange200JSONResponse struct {
+	Body    LoginResponse
+	Headers PostAuthExchange200ResponseHeaders
+}
+
+func (response PostAuthExchange200JSONResponse) VisitPostAuthExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAuthExchange400JSONResponse DefaultResponse
+
+func (response PostAuthExchange400JSONResponse) VisitPostAuthExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthExchange403JSONResponse struct{ AccountDisabledJSONResponse }
+
+func (response PostAuthExchange403JSONResponse) VisitPostAuthExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthExchange429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostAuthExchange429JSONResponse) VisitPostAuthExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAuthMagicLinkRequestObject struct {
+	Body *PostAuthMagicLinkJSONRequestBody
+}
+
+type PostAuthMagicLinkResponseObject interface {
+	VisitPostAuthMagicLinkResponse(w http.ResponseWriter) error
+}
+
+type PostAuthMagicLink200Response struct {
+}
+
+func (response PostAuthMagicLink200Response) VisitPostAuthMagicLinkResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAuthMagicLink400Response struct {
+}
+
+func (response PostAuthMagicLink400Response) VisitPostAuthMagicLinkResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PostAuthMagicLink429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostAuthMagicLink429JSONResponse) VisitPostAuthMagicLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAuthMagicLinkConfirmRequestObject struct {
+	Body *PostAuthMagicLinkConfirmJSONRequestBody
+}
+
+type PostAuthMagicLinkConfirmResponseObject interface {
+	VisitPostAuthMagicLinkConfirmResponse(w http.ResponseWriter) error
+}
+
+type PostAuthMagicLinkConfirm200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PostAuthMagicLinkConfirm200JSONResponse struct {
+	Body    LoginResponse
+	Headers PostAuthMagicLinkConfirm200ResponseHeaders
+}
+
+func (response PostAuthMagicLinkConfirm200JSONResponse) VisitPostAuthMagicLinkConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAuthMagicLinkConfirm403JSONResponse struct{ TokenErrorJSONResponse }
+
+func (response PostAuthMagicLinkConfirm403JSONResponse) VisitPostAuthMagicLinkConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeyLoginRequestObject struct {
+	Body *PostAuthPasskeyLoginJSONRequestBody
+}
+
+type PostAuthPasskeyLoginResponseObject interface {
+	VisitPostAuthPasskeyLoginResponse(w http.ResponseWriter) error
+}
+
+type PostAuthPasskeyLogin200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PostAuthPasskeyLogin200JSONResponse struct {
+	Body    AuthTokens
+	Headers PostAuthPasskeyLogin200ResponseHeaders
+}
+
+func (response PostAuthPasskeyLogin200JSONResponse) VisitPostAuthPasskeyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAuthPasskeyLogin401JSONResponse DefaultResponse
+
+func (response PostAuthPasskeyLogin401JSONResponse) VisitPostAuthPasskeyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeyLogin403JSONResponse struct{ AccountDisabledJSONResponse }
+
+func (response PostAuthPasskeyLogin403JSONResponse) VisitPostAuthPasskeyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasskeyLogin429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostAuthPasskeyLogin429JSONResponse) VisitPostAuthPasskeyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAuthPasskeyOptionsRequestObject struct {
+}
+
+type PostAuthPasskeyOptionsResponseObject interface {
+	VisitPostAuthPasskeyOptionsResponse(w http.ResponseWriter) error
+}
+
+type PostAuthPasskeyOptions200JSONResponse PasskeyOptions
+
+func (response PostAuthPasskeyOptions200JSONResponse) VisitPostAuthPasskeyOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasswordResetRequestObject struct {
+	Body *PostAuthPasswordResetJSONRequestBody
+}
+
+type PostAuthPasswordResetResponseObject interface {
+	VisitPostAuthPasswordResetResponse(w http.ResponseWriter) error
+}
+
+type PostAuthPasswordReset200Response struct {
+}
+
+func (response PostAuthPasswordReset200Response) VisitPostAuthPasswordResetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAuthPasswordReset400Response struct {
+}
+
+func (response PostAuthPasswordReset400Response) VisitPostAuthPasswordResetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PostAuthPasswordReset429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostAuthPasswordReset429JSONResponse) VisitPostAuthPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAuthPasswordResetConfirmRequestObject struct {
+	Body *PostAuthPasswordResetConfirmJSONRequestBody
+}
+
+type PostAuthPasswordResetConfirmResponseObject interface {
+	VisitPostAuthPasswordResetConfirmResponse(w http.ResponseWriter) error
+}
+
+type PostAuthPasswordResetConfirm200Response struct {
+}
+
+func (response PostAuthPasswordResetConfirm200Response) VisitPostAuthPasswordResetConfirmResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAuthPasswordResetConfirm400JSONResponse PasswordError
+
+func (response PostAuthPasswordResetConfirm400JSONResponse) VisitPostAuthPasswordResetConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasswordResetConfirm403JSONResponse struct{ TokenErrorJSONResponse }
+
+func (response PostAuthPasswordResetConfirm403JSONResponse) VisitPostAuthPasswordResetConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthPasswordResetVerifyRequestObject struct {
+	Body *PostAuthPasswordResetVerifyJSONRequestBody
+}
+
+type PostAuthPasswordResetVerifyResponseObject interface {
+	VisitPostAuthPasswordResetVerifyResponse(w http.ResponseWriter) error
+}
+
+type PostAuthPasswordResetVerify200Response struct {
+}
+
+func (response PostAuthPasswordResetVerify200Response) VisitPostAuthPasswordResetVerifyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAuthPasswordResetVerify403JSONResponse struct{ TokenErrorJSONResponse }
+
+func (response PostAuthPasswordResetVerify403JSONResponse) VisitPostAuthPasswordResetVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRefreshTokenRequestObject struct {
+	Params PostAuthRefreshTokenParams
+	Body   *PostAuthRefreshTokenJSONRequestBody
+}
+
+type PostAuthRefreshTokenResponseObject interface {
+	VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error
+}
+
+type PostAuthRefreshToken200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PostAuthRefreshToken200JSONResponse struct {
+	Body    AuthTokens
+	Headers PostAuthRefreshToken200ResponseHeaders
+}
+
+func (response PostAuthRefreshToken200JSONResponse) VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAuthRefreshToken403Response struct {
+}
+
+func (response PostAuthRefreshToken403Response) VisitPostAuthRefreshTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetAuthProviderAuthorizeRequestObject struct {
+	Provider ProviderParam `json:"provider"`
+	Params   GetAuthProviderAuthorizeParams
+}
+
+type GetAuthProviderAuthorizeResponseObject interface {
+	VisitGetAuthProviderAuthorizeResponse(w http.ResponseWriter) error
+}
+
+type GetAuthProviderAuthorize303ResponseHeaders struct {
+	Location  string
+	SetCookie string
+}
+
+type GetAuthProviderAuthorize303Response struct {
+	Headers GetAuthProviderAuthorize303ResponseHeaders
+}
+
+func (response GetAuthProviderAuthorize303Response) VisitGetAuthProviderAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(303)
+	return nil
+}
+
+type GetAuthProviderAuthorize400JSONResponse DefaultResponse
+
+func (response GetAuthProviderAuthorize400JSONResponse) VisitGetAuthProviderAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthProviderAuthorize404JSONResponse DefaultResponse
+
+func (response GetAuthProviderAuthorize404JSONResponse) VisitGetAuthProviderAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthProviderCallbackRequestObject struct {
+	Provider ProviderParam `json:"provider"`
+	Params   GetAuthProviderCallbackParams
+}
+
+type GetAuthProviderCallbackResponseObject interface {
+	VisitGetAuthProviderCallbackResponse(w http.ResponseWriter) error
+}
+
+type GetAuthProviderCallback200TexthtmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetAuthProviderCallback200TexthtmlResponse) VisitGetAuthProviderCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAuthProviderCallback303ResponseHeaders struct {
+	Location string
+}
+
+type GetAuthProviderCallback303Response struct {
+	Headers GetAuthProviderCallback303ResponseHeaders
+}
+
+func (response GetAuthProviderCallback303Response) VisitGetAuthProviderCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(303)
+	return nil
+}
+
+type GetAuthProviderCallback400JSONResponse DefaultResponse
+
+func (response GetAuthProviderCallback400JSONResponse) VisitGetAuthProviderCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthProviderCallback404JSONResponse DefaultResponse
+
+func (response GetAuthProviderCallback404JSONResponse) VisitGetAuthProviderCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFocusSessionsRequestObject struct {
+	Params GetFocusSessionsParams
+}
+
+type GetFocusSessionsResponseObject interface {
+	VisitGetFocusSessionsResponse(w http.ResponseWriter) error
+}
+
+type GetFocusSessions200JSONResponse struct {
+	Data       *[]FocusSession     `json:"data,omitempty"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+}
+
+func (response GetFocusSessions200JSONResponse) VisitGetFocusSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFocusSessions400Response struct {
+}
+
+func (response GetFocusSessions400Response) VisitGetFocusSessionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetFocusSessions403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetFocusSessions403JSONResponse) VisitGetFocusSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFocusSessionsRequestObject struct {
+	Body *PostFocusSessionsJSONRequestBody
+}
+
+type PostFocusSessionsResponseObject interface {
+	VisitPostFocusSessionsResponse(w http.ResponseWriter) error
+}
+
+type PostFocusSessions201JSONResponse FocusSession
+
+func (response PostFocusSessions201JSONResponse) VisitPostFocusSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFocusSessions400Response struct {
+}
+
+func (response PostFocusSessions400Response) VisitPostFocusSessionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PostFocusSessions403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostFocusSessions403JSONResponse) VisitPostFocusSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFocusSessions404Response struct {
+}
+
+func (response PostFocusSessions404Response) VisitPostFocusSessionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostFocusSessionsIdEndRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PostFocusSessionsIdEndJSONRequestBody
+}
+
+type PostFocusSessionsIdEndResponseObject interface {
+	VisitPostFocusSessionsIdEndResponse(w http.ResponseWriter) error
+}
+
+type PostFocusSessionsIdEnd200JSONResponse FocusSession
+
+func (response PostFocusSessionsIdEnd200JSONResponse) VisitPostFocusSessionsIdEndResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFocusSessionsIdEnd400Response struct {
+}
+
+func (response PostFocusSessionsIdEnd400Response) VisitPostFocusSessionsIdEndResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PostFocusSessionsIdEnd403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostFocusSessionsIdEnd403JSONResponse) VisitPostFocusSessionsIdEndResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostFocusSessionsIdEnd404Response struct {
+}
+
+func (response PostFocusSessionsIdEnd404Response) VisitPostFocusSessionsIdEndResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostLoginRequestObject struct {
+	Body *PostLoginJSONRequestBody
+}
+
+type PostLoginResponseObject interface {
+	VisitPostLoginResponse(w http.ResponseWriter) error
+}
+
+type PostLogin200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PostLogin200JSONResponse struct {
+	Body    LoginResponse
+	Headers PostLogin200ResponseHeaders
+}
+
+func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostLogin400Response struct {
+}
+
+func (response PostLogin400Response) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PostLogin403JSONResponse struct{ AccountDisabledJSONResponse }
+
+func (response PostLogin403JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin429ResponseHeaders struct {
+	RetryAfter int
+}
+
+type PostLogin429JSONResponse struct {
+	Body    DefaultResponse
+	Headers PostLogin429ResponseHeaders
+}
+
+func (response PostLogin429JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostLogin2faRequestObject struct {
+	Body *PostLogin2faJSONRequestBody
+}
+
+type PostLogin2faResponseObject interface {
+	VisitPostLogin2faResponse(w http.ResponseWriter) error
+}
+
+type PostLogin2fa200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PostLogin2fa200JSONResponse struct {
+	Body    AuthTokens
+	Headers PostLogin2fa200ResponseHeaders
+}
+
+func (response PostLogin2fa200JSONResponse) VisitPostLogin2faResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostLogin2fa400JSONResponse DefaultResponse
+
+func (response PostLogin2fa400JSONResponse) VisitPostLogin2faResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin2fa401JSONResponse DefaultResponse
+
+func (response PostLogin2fa401JSONResponse) VisitPostLogin2faResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin2fa403JSONResponse struct{ AccountDisabledJSONResponse }
+
+func (response PostLogin2fa403JSONResponse) VisitPostLogin2faResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin2fa429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostLogin2fa429JSONResponse) VisitPostLogin2faResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostLogoutRequestObject struct {
+	Params PostLogoutParams
+	Body   *PostLogoutJSONRequestBody
+}
+
+type PostLogoutResponseObject interface {
+	VisitPostLogoutResponse(w http.ResponseWriter) error
+}
+
+type PostLogout200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PostLogout200Response struct {
+	Headers PostLogout200ResponseHeaders
+}
+
+func (response PostLogout200Response) VisitPostLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostLogout403ResponseHeaders struct {
+	SetCookie string
+}
+
+type PostLogout403Response struct {
+	Headers PostLogout403ResponseHeaders
+}
+
+func (response PostLogout403Response) VisitPostLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(403)
+	return nil
+}
+
+type DeleteProfileRequestObject struct {
+	Body *DeleteProfileJSONRequestBody
+}
+
+type DeleteProfileResponseObject interface {
+	VisitDeleteProfileResponse(w http.ResponseWriter) error
+}
+
+type DeleteProfile202JSONResponse struct {
+	// DeletionScheduledAt When the account will be erased, unless the user logs in before
+	DeletionScheduledAt time.Time `json:"deletion_scheduled_at"`
+}
+
+func (response DeleteProfile202JSONResponse) VisitDeleteProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProfile400JSONResponse PasswordError
+
+func (response DeleteProfile400JSONResponse) VisitDeleteProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProfile401JSONResponse DefaultResponse
+
+func (response DeleteProfile401JSONResponse) VisitDeleteProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProfile403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteProfile403JSONResponse) VisitDeleteProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProfile429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response DeleteProfile429JSONResponse) VisitDeleteProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetProfileRequestObject struct {
+}
+
+type GetProfileResponseObject interface {
+	VisitGetProfileResponse(w http.ResponseWriter) error
+}
+
+type GetProfile200JSONResponse User
+
+func (response GetProfile200JSONResponse) VisitGetProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfile403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetProfile403JSONResponse) VisitGetProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProfileRequestObject struct {
+	Body *PatchProfileJSONRequestBody
+}
+
+type PatchProfileResponseObject interface {
+	VisitPatchProfileResponse(w http.ResponseWriter) error
+}
+
+type PatchProfile200JSONResponse User
+
+func (response PatchProfile200JSONResponse) VisitPatchProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProfile400JSONResponse DefaultResponse
+
+func (response PatchProfile400JSONResponse) VisitPatchProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProfile403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PatchProfile403JSONResponse) VisitPatchProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfile2faDisableRequestObject struct {
+	Body *PostProfile2faDisableJSONRequestBody
+}
+
+type PostProfile2faDisableResponseObject interface {
+	VisitPostProfile2faDisableResponse(w http.ResponseWriter) error
+}
+
+type PostProfile2faDisable204Response struct {
+}
+
+func (response PostProfile2faDisable204Response) VisitPostProfile2faDisableResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostProfile2faDisable400JSONResponse DefaultResponse
+
+func (response PostProfile2faDisable400JSONResponse) VisitPostProfile2faDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfile2faDisable403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostProfile2faDisable403JSONResponse) VisitPostProfile2faDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfile2faDisable404JSONResponse DefaultResponse
+
+func (response PostProfile2faDisable404JSONResponse) VisitPostProfile2faDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfile2faTotpRequestObject struct {
+}
+
+type PostProfile2faTotpResponseObject interface {
+	VisitPostProfile2faTotpResponse(w http.ResponseWriter) error
+}
+
+type PostProfile2faTotp200JSONResponse TotpEnrollment
+
+func (response PostProfile2faTotp200JSONResponse) VisitPostProfile2faTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfile2faTotp403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostProfile2faTotp403JSONResponse) VisitPostProfile2faTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfile2faTotp409JSONResponse DefaultResponse
+
+func (response PostProfile2faTotp409JSONResponse) VisitPostProfile2faTotpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfile2faTotpConfirmRequestObject struct {
+	Body *PostProfile2faTotpConfirmJSONRequestBody
+}
+
+type PostProfile2faTotpConfirmResponseObject interface {
+	VisitPostProfile2faTotpConfirmResponse(w http.ResponseWriter) error
+}
+
+type PostProfile2faTotpConfirm200JSONResponse struct {
+	// RecoveryCodes One-time codes to log in without the authenticator, only shown once
+	RecoveryCodes *[]string `json:"recovery_codes,omitempty"`
+}
+
+func (response PostProfile2faTotpConfirm200JSONResponse) VisitPostProfile2faTotpConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfile2faTotpConfirm400JSONResponse DefaultResponse
+
+func (response PostProfile2faTotpConfirm400JSONResponse) VisitPostProfile2faTotpConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfile2faTotpConfirm403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostProfile2faTotpConfirm403JSONResponse) VisitPostProfile2faTotpConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfile2faTotpConfirm404JSONResponse DefaultResponse
+
+func (response PostProfile2faTotpConfirm404JSONResponse) VisitPostProfile2faTotpConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfile2faTotpConfirm409JSONResponse DefaultResponse
+
+func (response PostProfile2faTotpConfirm409JSONResponse) VisitPostProfile2faTotpConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfileEmailRequestObject struct {
+	Body *PostProfileEmailJSONRequestBody
+}
+
+type PostProfileEmailResponseObject interface {
+	VisitPostProfileEmailResponse(w http.ResponseWriter) error
+}
+
+type PostProfileEmail200Response struct {
+}
+
+func (response PostProfileEmail200Response) VisitPostProfileEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProfileEmail400JSONResponse PasswordError
+
+func (response PostProfileEmail400JSONResponse) VisitPostProfileEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfileEmail401JSONResponse DefaultResponse
+
+func (response PostProfileEmail401JSONResponse) VisitPostProfileEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfileEmail403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostProfileEmail403JSONResponse) VisitPostProfileEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfileEmail409JSONResponse DefaultResponse
+
+func (response PostProfileEmail409JSONResponse) VisitPostProfileEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfileEmail429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostProfileEmail429JSONResponse) VisitPostProfileEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostProfileEmailConfirmRequestObject struct {
+	Body *PostProfileEmailConfirmJSONRequestBody
+}
+
+type PostProfileEmailConfirmResponseObject interface {
+	VisitPostProfileEmailConfirmResponse(w http.ResponseWriter) error
+}
+
+type PostProfileEmailConfirm200Response struct {
+}
+
+func (response PostProfileEmailConfirm200Response) VisitPostProfileEmailConfirmResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProfileEmailConfirm400JSONResponse struct{ TokenErrorJSONResponse }
+
+func (response PostProfileEmailConfirm400JSONResponse) VisitPostProfileEmailConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfileEmailConfirm403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostProfileEmailConfirm403JSONResponse) VisitPostProfileEmailConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfileEmailConfirm409JSONResponse DefaultResponse
+
+func (response PostProfileEmailConfirm409JSONResponse) VisitPostProfileEmailConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfileIdentitiesRequestObject struct {
+	Body *PostProfileIdentitiesJSONRequestBody
+}
+
+type PostProfileIdentitiesResponseObject interface {
+	VisitPostProfileIdentitiesResponse(w http.ResponseWriter) error
+}
+
+type PostProfileIdentities200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PostProfileIdentities200JSONResponse struct {
+	Body struct {
+		AuthorizationUrl string `json:"authorization_url"`
+	}
+	Headers PostProfileIdentities200ResponseHeaders
+}
+
+func (response PostProfileIdentities200JSONResponse) VisitPostProfileIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostProfileIdentities400JSONResponse DefaultResponse
+
+func (response PostProfileIdentities400JSONResponse) VisitPostProfileIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfileIdentities403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostProfileIdentities403JSONResponse) VisitPostProfileIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfileIdentities404JSONResponse DefaultResponse
+
+func (response PostProfileIdentities404JSONResponse) VisitPostProfileIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProfileIdentitiesIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteProfileIdentitiesIdResponseObject interface {
+	VisitDeleteProfileIdentitiesIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteProfileIdentitiesId204Response struct {
+}
+
+func (response DeleteProfileIdentitiesId204Response) VisitDeleteProfileIdentitiesIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteProfileIdentitiesId403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteProfileIdentitiesId403JSONResponse) VisitDeleteProfileIdentitiesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProfileIdentitiesId404JSONResponse DefaultResponse
+
+func (response DeleteProfileIdentitiesId404JSONResponse) VisitDeleteProfileIdentitiesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProfileIdentitiesId409JSONResponse DefaultResponse
+
+func (response DeleteProfileIdentitiesId409JSONResponse) VisitDeleteProfileIdentitiesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfilePasskeysRequestObject struct {
+}
+
+type GetProfilePasskeysResponseObject interface {
+	VisitGetProfilePasskeysResponse(w http.ResponseWriter) error
+}
+
+type GetProfilePasskeys200JSONResponse []Passkey
+
+func (response GetProfilePasskeys200JSONResponse) VisitGetProfilePasskeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfilePasskeys403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetProfilePasskeys403JSONResponse) VisitGetProfilePasskeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfilePasskeysRequestObject struct {
+	Body *PostProfilePasskeysJSONRequestBody
+}
+
+type PostProfilePasskeysResponseObject interface {
+	VisitPostProfilePasskeysResponse(w http.ResponseWriter) error
+}
+
+type PostProfilePasskeys201JSONResponse Passkey
+
+func (response PostProfilePasskeys201JSONResponse) VisitPostProfilePasskeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfilePasskeys400JSONResponse DefaultResponse
+
+func (response PostProfilePasskeys400JSONResponse) VisitPostProfilePasskeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfilePasskeys403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostProfilePasskeys403JSONResponse) VisitPostProfilePasskeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfilePasskeys409JSONResponse DefaultResponse
+
+func (response PostProfilePasskeys409JSONResponse) VisitPostProfilePasskeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfilePasskeysOptionsRequestObject struct {
+}
+
+type PostProfilePasskeysOptionsResponseObject interface {
+	VisitPostProfilePasskeysOptionsResponse(w http.ResponseWriter) error
+}
+
+type PostProfilePasskeysOptions200JSONResponse PasskeyOptions
+
+func (response PostProfilePasskeysOptions200JSONResponse) VisitPostProfilePasskeysOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfilePasskeysOptions403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostProfilePasskeysOptions403JSONResponse) VisitPostProfilePasskeysOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProfilePasskeysIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteProfilePasskeysIdResponseObject interface {
+	VisitDeleteProfilePasskeysIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteProfilePasskeysId204Response struct {
+}
+
+func (response DeleteProfilePasskeysId204Response) VisitDeleteProfilePasskeysIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteProfilePasskeysId403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteProfilePasskeysId403JSONResponse) VisitDeleteProfilePasskeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProfilePasskeysId404JSONResponse DefaultResponse
+
+func (response DeleteProfilePasskeysId404JSONResponse) VisitDeleteProfilePasskeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfilePasswordRequestObject struct {
+	Body *PostProfilePasswordJSONRequestBody
+}
+
+type PostProfilePasswordResponseObject interface {
+	VisitPostProfilePasswordResponse(w http.ResponseWriter) error
+}
+
+type PostProfilePassword200Response struct {
+}
+
+func (response PostProfilePassword200Response) VisitPostProfilePasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostProfilePassword400JSONResponse PasswordError
+
+func (response PostProfilePassword400JSONResponse) VisitPostProfilePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfilePassword403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostProfilePassword403JSONResponse) VisitPostProfilePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProfilePassword429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostProfilePassword429JSONResponse) VisitPostProfilePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetProfileSecurityEventsRequestObject struct {
+	Params GetProfileSecurityEventsParams
+}
+
+type GetProfileSecurityEventsResponseObject interface {
+	VisitGetProfileSecurityEventsResponse(w http.ResponseWriter) error
+}
+
+type GetProfileSecurityEvents200JSONResponse struct {
+	Data       *[]SecurityEvent    `json:"data,omitempty"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+}
+
+func (response GetProfileSecurityEvents200JSONResponse) VisitGetProfileSecurityEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileSecurityEvents400JSONResponse DefaultResponse
+
+func (response GetProfileSecurityEvents400JSONResponse) VisitGetProfileSecurityEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProfileSecurityEvents403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetProfileSecurityEvents403JSONResponse) VisitGetProfileSecurityEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegisterRequestObject struct {
+	Body *PostRegisterJSONRequestBody
+}
+
+type PostRegisterResponseObject interface {
+	VisitPostRegisterResponse(w http.ResponseWriter) error
+}
+
+type PostRegister201JSONResponse AuthTokens
+
+func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegister400JSONResponse RegisterError
+
+func (response PostRegister400JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegister429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PostRegister429JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSessionsRequestObject struct {
+}
+
+type GetSessionsResponseObject interface {
+	VisitGetSessionsResponse(w http.ResponseWriter) error
+}
+
+type GetSessions200JSONResponse []Session
+
+func (response GetSessions200JSONResponse) VisitGetSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessions403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetSessions403JSONResponse) VisitGetSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSessionsLogoutOthersRequestObject struct {
+	Params PostSessionsLogoutOthersParams
+	Body   *PostSessionsLogoutOthersJSONRequestBody
+}
+
+type PostSessionsLogoutOthersResponseObject interface {
+	VisitPostSessionsLogoutOthersResponse(w http.ResponseWriter) error
+}
+
+type PostSessionsLogoutOthers200JSONResponse struct {
+	// Revoked Number of revoked sessions
+	Revoked *int `json:"revoked,omitempty"`
+}
+
+func (response PostSessionsLogoutOthers200JSONResponse) VisitPostSessionsLogoutOthersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSessionsLogoutOthers403Response struct {
+}
+
+func (response PostSessionsLogoutOthers403Response) VisitPostSessionsLogoutOthersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type DeleteSessionsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteSessionsIdResponseObject interface {
+	VisitDeleteSessionsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteSessionsId204Response struct {
+}
+
+func (response DeleteSessionsId204Response) VisitDeleteSessionsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSessionsId403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteSessionsId403JSONResponse) VisitDeleteSessionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSessionsId404JSONResponse DefaultResponse
+
+func (response DeleteSessionsId404JSONResponse) VisitDeleteSessionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskViewsRequestObject struct {
+}
+
+type GetTaskViewsResponseObject interface {
+	VisitGetTaskViewsResponse(w http.ResponseWriter) error
+}
+
+type GetTaskViews200JSONResponse []TaskView
+
+func (response GetTaskViews200JSONResponse) VisitGetTaskViewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskViews403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetTaskViews403JSONResponse) VisitGetTaskViewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskViewsRequestObject struct {
+	Body *PostTaskViewsJSONRequestBody
+}
+
+type PostTaskViewsResponseObject interface {
+	VisitPostTaskViewsResponse(w http.ResponseWriter) error
+}
+
+type PostTaskViews201JSONResponse TaskView
+
+func (response PostTaskViews201JSONResponse) VisitPostTaskViewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskViews400JSONResponse DefaultResponse
+
+func (response PostTaskViews400JSONResponse) VisitPostTaskViewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskViews403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostTaskViews403JSONResponse) VisitPostTaskViewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTaskViews409JSONResponse DefaultResponse
+
+func (response PostTaskViews409JSONResponse) VisitPostTaskViewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTaskViewsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteTaskViewsIdResponseObject interface {
+	VisitDeleteTaskViewsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTaskViewsId204Response struct {
+}
+
+func (response DeleteTaskViewsId204Response) VisitDeleteTaskViewsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTaskViewsId403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteTaskViewsId403JSONResponse) VisitDeleteTaskViewsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTaskViewsId404JSONResponse DefaultResponse
+
+func (response DeleteTaskViewsId404JSONResponse) VisitDeleteTaskViewsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskViewsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetTaskViewsIdResponseObject interface {
+	VisitGetTaskViewsIdResponse(w http.ResponseWriter) error
+}
+
+type GetTaskViewsId200JSONResponse TaskView
+
+func (response GetTaskViewsId200JSONResponse) VisitGetTaskViewsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskViewsId403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetTaskViewsId403JSONResponse) VisitGetTaskViewsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskViewsId404JSONResponse DefaultResponse
+
+func (response GetTaskViewsId404JSONResponse) VisitGetTaskViewsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTaskViewsIdRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PutTaskViewsIdJSONRequestBody
+}
+
+type PutTaskViewsIdResponseObject interface {
+	VisitPutTaskViewsIdResponse(w http.ResponseWriter) error
+}
+
+type PutTaskViewsId200Response struct {
+}
+
+func (response PutTaskViewsId200Response) VisitPutTaskViewsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutTaskViewsId400JSONResponse DefaultResponse
+
+func (response PutTaskViewsId400JSONResponse) VisitPutTaskViewsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTaskViewsId403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PutTaskViewsId403JSONResponse) VisitPutTaskViewsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTaskViewsId404JSONResponse DefaultResponse
+
+func (response PutTaskViewsId404JSONResponse) VisitPutTaskViewsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTaskViewsId409JSONResponse DefaultResponse
+
+func (response PutTaskViewsId409JSONResponse) VisitPutTaskViewsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskViewsIdTasksRequestObject struct {
+	Id     int32 `json:"id"`
+	Params GetTaskViewsIdTasksParams
+}
+
+type GetTaskViewsIdTasksResponseObject interface {
+	VisitGetTaskViewsIdTasksResponse(w http.ResponseWriter) error
+}
+
+type GetTaskViewsIdTasks200JSONResponse struct {
+	Data       *[]Task             `json:"data,omitempty"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+}
+
+func (response GetTaskViewsIdTasks200JSONResponse) VisitGetTaskViewsIdTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskViewsIdTasks400Response struct {
+}
+
+func (response GetTaskViewsIdTasks400Response) VisitGetTaskViewsIdTasksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetTaskViewsIdTasks403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetTaskViewsIdTasks403JSONResponse) VisitGetTaskViewsIdTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTaskViewsIdTasks404JSONResponse DefaultResponse
+
+func (response GetTaskViewsIdTasks404JSONResponse) VisitGetTaskViewsIdTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasksRequestObject struct {
+	Params GetTasksParams
+}
+
+type GetTasksResponseObject interface {
+	VisitGetTasksResponse(w http.ResponseWriter) error
+}
+
+type GetTasks200JSONResponse struct {
+	Data       *[]Task             `json:"data,omitempty"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+}
+
+func (response GetTasks200JSONResponse) VisitGetTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasks400Response struct {
+}
+
+func (response GetTasks400Response) VisitGetTasksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetTasks403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetTasks403JSONResponse) VisitGetTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksRequestObject struct {
+	Body *PostTasksJSONRequestBody
+}
+
+type PostTasksResponseObject interface {
+	VisitPostTasksResponse(w http.ResponseWriter) error
+}
+
+type PostTasks201JSONResponse Task
+
+func (response PostTasks201JSONResponse) VisitPostTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasks400JSONResponse DefaultResponse
+
+func (response PostTasks400JSONResponse) VisitPostTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasks403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostTasks403JSONResponse) VisitPostTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksBatchRequestObject struct {
+	Body *PostTasksBatchJSONRequestBody
+}
+
+type PostTasksBatchResponseObject interface {
+	VisitPostTasksBatchResponse(w http.ResponseWriter) error
+}
+
+type PostTasksBatch200JSONResponse BatchTaskResponse
+
+func (response PostTasksBatch200JSONResponse) VisitPostTasksBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksBatch400Response struct {
+}
+
+func (response PostTasksBatch400Response) VisitPostTasksBatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PostTasksBatch403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostTasksBatch403JSONResponse) VisitPostTasksBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksBatch409JSONResponse BatchTaskResponse
+
+func (response PostTasksBatch409JSONResponse) VisitPostTasksBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasksTrashRequestObject struct {
+	Params GetTasksTrashParams
+}
+
+type GetTasksTrashResponseObject interface {
+	VisitGetTasksTrashResponse(w http.ResponseWriter) error
+}
+
+type GetTasksTrash200JSONResponse struct {
+	Data       *[]Task             `json:"data,omitempty"`
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+}
+
+func (response GetTasksTrash200JSONResponse) VisitGetTasksTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTasksTrash400Response struct {
+}
+
+func (response GetTasksTrash400Response) VisitGetTasksTrashResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetTasksTrash403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetTasksTrash403JSONResponse) VisitGetTasksTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteTasksIdResponseObject interface {
+	VisitDeleteTasksIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTasksId204Response struct {
+}
+
+func (response DeleteTasksId204Response) VisitDeleteTasksIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTasksId403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteTasksId403JSONResponse) VisitDeleteTasksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTasksId404JSONResponse DefaultResponse
+
+func (response DeleteTasksId404JSONResponse) VisitDeleteTasksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTasksIdRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PutTasksIdJSONRequestBody
+}
+
+type PutTasksIdResponseObject interface {
+	VisitPutTasksIdResponse(w http.ResponseWriter) error
+}
+
+type PutTasksId200Response struct {
+}
+
+func (response PutTasksId200Response) VisitPutTasksIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutTasksId403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PutTasksId403JSONResponse) VisitPutTasksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutTasksId404JSONResponse DefaultResponse
+
+func (response PutTasksId404JSONResponse) VisitPutTasksIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdRestoreRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type PostTasksIdRestoreResponseObject interface {
+	VisitPostTasksIdRestoreResponse(w http.ResponseWriter) error
+}
+
+type PostTasksIdRestore200Response struct {
+}
+
+func (response PostTasksIdRestore200Response) VisitPostTasksIdRestoreResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostTasksIdRestore403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostTasksIdRestore403JSONResponse) VisitPostTasksIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTasksIdRestore404JSONResponse DefaultResponse
+
+func (response PostTasksIdRestore404JSONResponse) VisitPostTasksIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get the public keys used to verify the issued JWTs
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(ctx context.Context, request GetWellKnownJwksJsonRequestObject) (GetWellKnownJwksJsonResponseObject, error)
+	// Activate user account
+	// (POST /activation)
+	PostActivation(ctx context.Context, request PostActivationRequestObject) (PostActivationResponseObject, error)
+	// Send activation email
+	// (POST /activation/email)
+	PostActivationEmail(ctx context.Context, request PostActivationEmailRequestObject) (PostActivationEmailResponseObject, error)
+	// Get the audit events of every user
+	// (GET /admin/security-events)
+	GetAdminSecurityEvents(ctx context.Context, request GetAdminSecurityEventsRequestObject) (GetAdminSecurityEventsResponseObject, error)
+	// Get the usage counts of the whole system
+	// (GET /admin/stats)
+	GetAdminStats(ctx context.Context, request GetAdminStatsRequestObject) (GetAdminStatsResponseObject, error)
+	// List and search users
+	// (GET /admin/users)
+	GetAdminUsers(ctx context.Context, request GetAdminUsersRequestObject) (GetAdminUsersResponseObject, error)
+	// Get a user
+	// (GET /admin/users/{id})
+	GetAdminUsersId(ctx context.Context, request GetAdminUsersIdRequestObject) (GetAdminUsersIdResponseObject, error)
+	// Resend the activation email of a user
+	// (POST /admin/users/{id}/activation-email)
+	PostAdminUsersIdActivationEmail(ctx context.Context, request PostAdminUsersIdActivationEmailRequestObject) (PostAdminUsersIdActivationEmailResponseObject, error)
+	// Deactivate the account of a user
+	// (POST /admin/users/{id}/deactivate)
+	PostAdminUsersIdDeactivate(ctx context.Context, request PostAdminUsersIdDeactivateRequestObject) (PostAdminUsersIdDeactivateResponseObject, error)
+	// Reactivate the account of a user
+	// (POST /admin/users/{id}/reactivate)
+	PostAdminUsersIdReactivate(ctx context.Context, request PostAdminUsersIdReactivateRequestObject) (PostAdminUsersIdReactivateResponseObject, error)
+	// Log a user out of every session
+	// (DELETE /admin/users/{id}/sessions)
+	DeleteAdminUsersIdSessions(ctx context.Context, request DeleteAdminUsersIdSessionsRequestObject) (DeleteAdminUsersIdSessionsResponseObject, error)
+	// Get focus session analytics
+	// (GET /analytics/focus)
+	GetAnalyticsFocus(ctx context.Context, request GetAnalyticsFocusRequestObject) (GetAnalyticsFocusResponseObject, error)
+	// Login with the exchange code of an external provider login
+	// (POST /auth/exchange)
+	PostAuthExchange(ctx context.Context, request PostAuthExchangeRequestObject) (PostAuthExchangeResponseObject, error)
+	// Request a login link by email
+	// (POST /auth/magic-link)
+	PostAuthMagicLink(ctx context.Context, request PostAuthMagicLinkRequestObject) (PostAuthMagicLinkResponseObject, error)
+	// Login using the token of a login link
+	// (POST /auth/magic-link/confirm)
+	PostAuthMagicLinkConfirm(ctx context.Context, request PostAuthMagicLinkConfirmRequestObject) (PostAuthMagicLinkConfirmResponseObject, error)
+	// Login with a passkey
+	// (POST /auth/passkey/login)
+	PostAuthPasskeyLogin(ctx context.Context, request PostAuthPasskeyLoginRequestObject) (PostAuthPasskeyLoginResponseObject, error)
+	// Start a passkey login
+	// (POST /auth/passkey/options)
+	PostAuthPasskeyOptions(ctx context.Context, request PostAuthPasskeyOptionsRequestObject) (PostAuthPasskeyOptionsResponseObject, error)
+	// Request password reset email
+	// (POST /auth/password-reset)
+	PostAuthPasswordReset(ctx context.Context, request PostAuthPasswordResetRequestObject) (PostAuthPasswordResetResponseObject, error)
+	// Reset password using token
+	// (POST /auth/password-reset/confirm)
+	PostAuthPasswordResetConfirm(ctx context.Context, request PostAuthPasswordResetConfirmRequestObject) (PostAuthPasswordResetConfirmResponseObject, error)
+	// Verify password reset token validity
+	// (POST /auth/password-reset/verify)
+	PostAuthPasswordResetVerify(ctx context.Context, request PostAuthPasswordResetVerifyRequestObject) (PostAuthPasswordResetVerifyResponseObject, error)
+	// Get new access and refresh tokens using refresh token
+	// (POST /auth/refresh-token)
+	PostAuthRefreshToken(ctx context.Context, request PostAuthRefreshTokenRequestObject) (PostAuthRefreshTokenResponseObject, error)
+	// Initiate a login with an external provider
+	// (GET /auth/{provider}/authorize)
+	GetAuthProviderAuthorize(ctx context.Context, request GetAuthProviderAuthorizeRequestObject) (GetAuthProviderAuthorizeResponseObject, error)
+	// Handle the callback of an external provider
+	// (GET /auth/{provider}/callback)
+	GetAuthProviderCallback(ctx context.Context, request GetAuthProviderCallbackRequestObject) (GetAuthProviderCallbackResponseObject, error)
+	// Get list of user's focus sessions
+	// (GET /focus-sessions)
+	GetFocusSessions(ctx context.Context, request GetFocusSessionsRequestObject) (GetFocusSessionsResponseObject, error)
+	// Start a new focus session
+	// (POST /focus-sessions)
+	PostFocusSessions(ctx context.Context, request PostFocusSessionsRequestObject) (PostFocusSessionsResponseObject, error)
+	// End an active focus session
+	// (POST /focus-sessions/{id}/end)
+	PostFocusSessionsIdEnd(ctx context.Context, request PostFocusSessionsIdEndRequestObject) (PostFocusSessionsIdEndResponseObject, error)
+	// Login to the system
+	// (POST /login)
+	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
+	// Complete a login with a second factor
+	// (POST /login/2fa)
+	PostLogin2fa(ctx context.Context, request PostLogin2faRequestObject) (PostLogin2faResponseObject, error)
+	// Logout and invalidate refresh token
+	// (POST /logout)
+	PostLogout(ctx context.Context, request PostLogoutRequestObject) (PostLogoutResponseObject, error)
+	// Delete the account
+	// (DELETE /profile)
+	DeleteProfile(ctx context.Context, request DeleteProfileRequestObject) (DeleteProfileResponseObject, error)
+	// Get user profile
+	// (GET /profile)
+	GetProfile(ctx context.Context, request GetProfileRequestObject) (GetProfileResponseObject, error)
+	// Update the profile and preferences
+	// (PATCH /profile)
+	PatchProfile(ctx context.Context, request PatchProfileRequestObject) (PatchProfileResponseObject, error)
+	// Disable 2FA
+	// (POST /profile/2fa/disable)
+	PostProfile2faDisable(ctx context.Context, request PostProfile2faDisableRequestObject) (PostProfile2faDisableResponseObject, error)
+	// Start the TOTP enrollment
+	// (POST /profile/2fa/totp)
+	PostProfile2faTotp(ctx context.Context, request PostProfile2faTotpRequestObject) (PostProfile2faTotpResponseObject, error)
+	// Confirm the TOTP enrollment and enable 2FA
+	// (POST /profile/2fa/totp/confirm)
+	PostProfile2faTotpConfirm(ctx context.Context, request PostProfile2faTotpConfirmRequestObject) (PostProfile2faTotpConfirmResponseObject, error)
+	// Request a change of the email
+	// (POST /profile/email)
+	PostProfileEmail(ctx context.Context, request PostProfileEmailRequestObject) (PostProfileEmailResponseObject, error)
+	// Confirm a change of the email
+	// (POST /profile/email/confirm)
+	PostProfileEmailConfirm(ctx context.Context, request PostProfileEmailConfirmRequestObject) (PostProfileEmailConfirmResponseObject, error)
+	// Start linking an identity of an external provider
+	// (POST /profile/identities)
+	PostProfileIdentities(ctx context.Context, request PostProfileIdentitiesRequestObject) (PostProfileIdentitiesResponseObject, error)
+	// Unlink an identity of an external provider
+	// (DELETE /profile/identities/{id})
+	DeleteProfileIdentitiesId(ctx context.Context, request DeleteProfileIdentitiesIdRequestObject) (DeleteProfileIdentitiesIdResponseObject, error)
+	// Get list of user's passkeys
+	// (GET /profile/passkeys)
+	GetProfilePasskeys(ctx context.Context, request GetProfilePasskeysRequestObject) (GetProfilePasskeysResponseObject, error)
+	// Register a passkey
+	// (POST /profile/passkeys)
+	PostProfilePasskeys(ctx context.Context, request PostProfilePasskeysRequestObject) (PostProfilePasskeysResponseObject, error)
+	// Start the registration of a passkey
+	// (POST /profile/passkeys/options)
+	PostProfilePasskeysOptions(ctx context.Context, request PostProfilePasskeysOptionsRequestObject) (PostProfilePasskeysOptionsResponseObject, error)
+	// Remove a passkey
+	// (DELETE /profile/passkeys/{id})
+	DeleteProfilePasskeysId(ctx context.Context, request DeleteProfilePasskeysIdRequestObject) (DeleteProfilePasskeysIdResponseObject, error)
+	// Change the password
+	// (POST /profile/password)
+	PostProfilePassword(ctx context.Context, request PostProfilePasswordRequestObject) (PostProfilePasswordResponseObject, error)
+	// Get the security history of the user
+	// (GET /profile/security-events)
+	GetProfileSecurityEvents(ctx context.Context, request GetProfileSecurityEventsRequestObject) (GetProfileSecurityEventsResponseObject, error)
+	// Register a new user
+	// (POST /register)
+	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
+	// Get list of user's active sessions
+	// (GET /sessions)
+	GetSessions(ctx context.Context, request GetSessionsRequestObject) (GetSessionsResponseObject, error)
+	// Revoke every session except the current one
+	// (POST /sessions/logout-others)
+	PostSessionsLogoutOthers(ctx context.Context, request PostSessionsLogoutOthersRequestObject) (PostSessionsLogoutOthersResponseObject, error)
+	// Revoke a session
+	// (DELETE /sessions/{id})
+	DeleteSessionsId(ctx context.Context, request DeleteSessionsIdRequestObject) (DeleteSessionsIdResponseObject, error)
+	// Get list of user's saved task views
+	// (GET /task-views)
+	GetTaskViews(ctx context.Context, request GetTaskViewsRequestObject) (GetTaskViewsResponseObject, error)
+	// Save a new task view
+	// (POST /task-views)
+	PostTaskViews(ctx context.Context, request PostTaskViewsRequestObject) (PostTaskViewsResponseObject, error)
+	// Delete a saved task view
+	// (DELETE /task-views/{id})
+	DeleteTaskViewsId(ctx context.Context, request DeleteTaskViewsIdRequestObject) (DeleteTaskViewsIdResponseObject, error)
+	// Get a saved task view
+	// (GET /task-views/{id})
+	GetTaskViewsId(ctx context.Context, request GetTaskViewsIdRequestObject) (GetTaskViewsIdResponseObject, error)
+	// Replace the name and filters of a saved task view
+	// (PUT /task-views/{id})
+	PutTaskViewsId(ctx context.Context, request PutTaskViewsIdRequestObject) (PutTaskViewsIdResponseObject, error)
+	// Get the tasks matching a saved task view
+	// (GET /task-views/{id}/tasks)
+	GetTaskViewsIdTasks(ctx context.Context, request GetTaskViewsIdTasksRequestObject) (GetTaskViewsIdTasksResponseObject, error)
+	// Get list of user's tasks
+	// (GET /tasks)
+	GetTasks(ctx context.Context, request GetTasksRequestObject) (GetTasksResponseObject, error)
+	// Create a new task
+	// (POST /tasks)
+	PostTasks(ctx context.Context, request PostTasksRequestObject) (PostTasksResponseObject, error)
+	// Create, update and delete multiple tasks at once
+	// (POST /tasks/batch)
+	PostTasksBatch(ctx context.Context, request PostTasksBatchRequestObject) (PostTasksBatchResponseObject, error)
+	// Get list of user's deleted tasks
+	// (GET /tasks/trash)
+	GetTasksTrash(ctx context.Context, request GetTasksTrashRequestObject) (GetTasksTrashResponseObject, error)
+	// Move a task to the trash
+	// (DELETE /tasks/{id})
+	DeleteTasksId(ctx context.Context, request DeleteTasksIdRequestObject) (DeleteTasksIdResponseObject, error)
+	// Update an existing task
+	// (PUT /tasks/{id})
+	PutTasksId(ctx context.Context, request PutTasksIdRequestObject) (PutTasksIdResponseObject, error)
+	// Restore a deleted task from the trash
+	// (POST /tasks/{id}/restore)
+	PostTasksIdRestore(ctx context.Context, request PostTasksIdRestoreRequestObject) (PostTasksIdRestoreResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetWellKnownJwksJson operation middleware
+func (sh *strictHandler) GetWellKnownJwksJson(ctx echo.Context) error {
+	var request GetWellKnownJwksJsonRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWellKnownJwksJson(ctx.Request().Context(), request.(GetWellKnownJwksJsonRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWellKnownJwksJson")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetWellKnownJwksJsonResponseObject); ok {
+		return validResponse.VisitGetWellKnownJwksJsonResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostActivation operation middleware
+func (sh *strictHandler) PostActivation(ctx echo.Context) error {
+	var request PostActivationRequestObject
+
+	var body PostActivationJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostActivation(ctx.Request().Context(), request.(PostActivationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostActivation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostActivationResponseObject); ok {
+		return validResponse.VisitPostActivationResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostActivationEmail operation middleware
+func (sh *strictHandler) PostActivationEmail(ctx echo.Context) error {
+	var request PostActivationEmailRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostActivationEmail(ctx.Request().Context(), request.(PostActivationEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostActivationEmail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostActivationEmailResponseObject); ok {
+		return validResponse.VisitPostActivationEmailResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAdminSecurityEvents operation middleware
+func (sh *strictHandler) GetAdminSecurityEvents(ctx echo.Context, params GetAdminSecurityEventsParams) error {
+	var request GetAdminSecurityEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminSecurityEvents(ctx.Request().Context(), request.(GetAdminSecurityEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminSecurityEvents")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAdminSecurityEventsResponseObject); ok {
+		return validResponse.VisitGetAdminSecurityEventsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAdminStats operation middleware
+func (sh *strictHandler) GetAdminStats(ctx echo.Context) error {
+	var request GetAdminStatsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminStats(ctx.Request().Context(), request.(GetAdminStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminStats")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAdminStatsResponseObject); ok {
+		return validResponse.VisitGetAdminStatsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAdminUsers operation middleware
+func (sh *strictHandler) GetAdminUsers(ctx echo.Context, params GetAdminUsersParams) error {
+	var request GetAdminUsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminUsers(ctx.Request().Context(), request.(GetAdminUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminUsers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAdminUsersResponseObject); ok {
+		return validResponse.VisitGetAdminUsersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAdminUsersId operation middleware
+func (sh *strictHandler) GetAdminUsersId(ctx echo.Context, id UserIdParam) error {
+	var request GetAdminUsersIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminUsersId(ctx.Request().Context(), request.(GetAdminUsersIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminUsersId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAdminUsersIdResponseObject); ok {
+		return validResponse.VisitGetAdminUsersIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAdminUsersIdActivationEmail operation middleware
+func (sh *strictHandler) PostAdminUsersIdActivationEmail(ctx echo.Context, id UserIdParam) error {
+	var request PostAdminUsersIdActivationEmailRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersIdActivationEmail(ctx.Request().Context(), request.(PostAdminUsersIdActivationEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersIdActivationEmail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAdminUsersIdActivationEmailResponseObject); ok {
+		return validResponse.VisitPostAdminUsersIdActivationEmailResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAdminUsersIdDeactivate operation middleware
+func (sh *strictHandler) PostAdminUsersIdDeactivate(ctx echo.Context, id UserIdParam) error {
+	var request PostAdminUsersIdDeactivateRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersIdDeactivate(ctx.Request().Context(), request.(PostAdminUsersIdDeactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersIdDeactivate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAdminUsersIdDeactivateResponseObject); ok {
+		return validResponse.VisitPostAdminUsersIdDeactivateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAdminUsersIdReactivate operation middleware
+func (sh *strictHandler) PostAdminUsersIdReactivate(ctx echo.Context, id UserIdParam) error {
+	var request PostAdminUsersIdReactivateRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersIdReactivate(ctx.Request().Context(), request.(PostAdminUsersIdReactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersIdReactivate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAdminUsersIdReactivateResponseObject); ok {
+		return validResponse.VisitPostAdminUsersIdReactivateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteAdminUsersIdSessions operation middleware
+func (sh *strictHandler) DeleteAdminUsersIdSessions(ctx echo.Context, id UserIdParam) error {
+	var request DeleteAdminUsersIdSessionsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAdminUsersIdSessions(ctx.Request().Context(), request.(DeleteAdminUsersIdSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAdminUsersIdSessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteAdminUsersIdSessionsResponseObject); ok {
+		return validResponse.VisitDeleteAdminUsersIdSessionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAnalyticsFocus operation middleware
+func (sh *strictHandler) GetAnalyticsFocus(ctx echo.Context, params GetAnalyticsFocusParams) error {
+	var request GetAnalyticsFocusRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalyticsFocus(ctx.Request().Context(), request.(GetAnalyticsFocusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalyticsFocus")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAnalyticsFocusResponseObject); ok {
+		return validResponse.VisitGetAnalyticsFocusResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthExchange operation middleware
+func (sh *strictHandler) PostAuthExchange(ctx echo.Context) error {
+	var request PostAuthExchangeRequestObject
+
+	var body PostAuthExchangeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthExchange(ctx.Request().Context(), request.(PostAuthExchangeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthExchange")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthExchangeResponseObject); ok {
+		return validResponse.VisitPostAuthExchangeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthMagicLink operation middleware
+func (sh *strictHandler) PostAuthMagicLink(ctx echo.Context) error {
+	var request PostAuthMagicLinkRequestObject
+
+	var body PostAuthMagicLinkJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthMagicLink(ctx.Request().Context(), request.(PostAuthMagicLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthMagicLink")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthMagicLinkResponseObject); ok {
+		return validResponse.VisitPostAuthMagicLinkResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthMagicLinkConfirm operation middleware
+func (sh *strictHandler) PostAuthMagicLinkConfirm(ctx echo.Context) error {
+	var request PostAuthMagicLinkConfirmRequestObject
+
+	var body PostAuthMagicLinkConfirmJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthMagicLinkConfirm(ctx.Request().Context(), request.(PostAuthMagicLinkConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthMagicLinkConfirm")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthMagicLinkConfirmResponseObject); ok {
+		return validResponse.VisitPostAuthMagicLinkConfirmResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthPasskeyLogin operation middleware
+func (sh *strictHandler) PostAuthPasskeyLogin(ctx echo.Context) error {
+	var request PostAuthPasskeyLoginRequestObject
+
+	var body PostAuthPasskeyLoginJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthPasskeyLogin(ctx.Request().Context(), request.(PostAuthPasskeyLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthPasskeyLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthPasskeyLoginResponseObject); ok {
+		return validResponse.VisitPostAuthPasskeyLoginResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthPasskeyOptions operation middleware
+func (sh *strictHandler) PostAuthPasskeyOptions(ctx echo.Context) error {
+	var request PostAuthPasskeyOptionsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthPasskeyOptions(ctx.Request().Context(), request.(PostAuthPasskeyOptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthPasskeyOptions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthPasskeyOptionsResponseObject); ok {
+		return validResponse.VisitPostAuthPasskeyOptionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthPasswordReset operation middleware
+func (sh *strictHandler) PostAuthPasswordReset(ctx echo.Context) error {
+	var request PostAuthPasswordResetRequestObject
+
+	var body PostAuthPasswordResetJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthPasswordReset(ctx.Request().Context(), request.(PostAuthPasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthPasswordReset")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthPasswordResetResponseObject); ok {
+		return validResponse.VisitPostAuthPasswordResetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthPasswordResetConfirm operation middleware
+func (sh *strictHandler) PostAuthPasswordResetConfirm(ctx echo.Context) error {
+	var request PostAuthPasswordResetConfirmRequestObject
+
+	var body PostAuthPasswordResetConfirmJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthPasswordResetConfirm(ctx.Request().Context(), request.(PostAuthPasswordResetConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthPasswordResetConfirm")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthPasswordResetConfirmResponseObject); ok {
+		return validResponse.VisitPostAuthPasswordResetConfirmResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthPasswordResetVerify operation middleware
+func (sh *strictHandler) PostAuthPasswordResetVerify(ctx echo.Context) error {
+	var request PostAuthPasswordResetVerifyRequestObject
+
+	var body PostAuthPasswordResetVerifyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthPasswordResetVerify(ctx.Request().Context(), request.(PostAuthPasswordResetVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthPasswordResetVerify")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthPasswordResetVerifyResponseObject); ok {
+		return validResponse.VisitPostAuthPasswordResetVerifyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthRefreshToken operation middleware
+func (sh *strictHandler) PostAuthRefreshToken(ctx echo.Context, params PostAuthRefreshTokenParams) error {
+	var request PostAuthRefreshTokenRequestObject
+
+	request.Params = params
+
+	var body PostAuthRefreshTokenJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthRefreshToken(ctx.Request().Context(), request.(PostAuthRefreshTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthRefreshToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthRefreshTokenResponseObject); ok {
+		return validResponse.VisitPostAuthRefreshTokenResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAuthProviderAuthorize operation middleware
+func (sh *strictHandler) GetAuthProviderAuthorize(ctx echo.Context, provider ProviderParam, params GetAuthProviderAuthorizeParams) error {
+	var request GetAuthProviderAuthorizeRequestObject
+
+	request.Provider = provider
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthProviderAuthorize(ctx.Request().Context(), request.(GetAuthProviderAuthorizeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthProviderAuthorize")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAuthProviderAuthorizeResponseObject); ok {
+		return validResponse.VisitGetAuthProviderAuthorizeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAuthProviderCallback operation middleware
+func (sh *strictHandler) GetAuthProviderCallback(ctx echo.Context, provider ProviderParam, params GetAuthProviderCallbackParams) error {
+	var request GetAuthProviderCallbackRequestObject
+
+	request.Provider = provider
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthProviderCallback(ctx.Request().Context(), request.(GetAuthProviderCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthProviderCallback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAuthProviderCallbackResponseObject); ok {
+		return validResponse.VisitGetAuthProviderCallbackResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetFocusSessions operation middleware
+func (sh *strictHandler) GetFocusSessions(ctx echo.Context, params GetFocusSessionsParams) error {
+	var request GetFocusSessionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFocusSessions(ctx.Request().Context(), request.(GetFocusSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFocusSessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetFocusSessionsResponseObject); ok {
+		return validResponse.VisitGetFocusSessionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostFocusSessions operation middleware
+func (sh *strictHandler) PostFocusSessions(ctx echo.Context) error {
+	var request PostFocusSessionsRequestObject
+
+	var body PostFocusSessionsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostFocusSessions(ctx.Request().Context(), request.(PostFocusSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostFocusSessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostFocusSessionsResponseObject); ok {
+		return validResponse.VisitPostFocusSessionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostFocusSessionsIdEnd operation middleware
+func (sh *strictHandler) PostFocusSessionsIdEnd(ctx echo.Context, id int32) error {
+	var request PostFocusSessionsIdEndRequestObject
+
+	request.Id = id
+
+	var body PostFocusSessionsIdEndJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostFocusSessionsIdEnd(ctx.Request().Context(), request.(PostFocusSessionsIdEndRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostFocusSessionsIdEnd")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostFocusSessionsIdEndResponseObject); ok {
+		return validResponse.VisitPostFocusSessionsIdEndResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostLogin operation middleware
+func (sh *strictHandler) PostLogin(ctx echo.Context) error {
+	var request PostLoginRequestObject
+
+	var body PostLoginJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLogin(ctx.Request().Context(), request.(PostLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
+		return validResponse.VisitPostLoginResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostLogin2fa operation middleware
+func (sh *strictHandler) PostLogin2fa(ctx echo.Context) error {
+	var request PostLogin2faRequestObject
+
+	var body PostLogin2faJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLogin2fa(ctx.Request().Context(), request.(PostLogin2faRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLogin2fa")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostLogin2faResponseObject); ok {
+		return validResponse.VisitPostLogin2faResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type PutTasksId403JSONResponse struct{ ForbiddenJSONResponse }
+// PostLogout operation middleware
+func (sh *strictHandler) PostLogout(ctx echo.Context, params PostLogoutParams) error {
+	var request PostLogoutRequestObject
 
-func (response PutTasksId403JSONResponse) VisitPutTasksIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	var body PostLogoutJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLogout(ctx.Request().Context(), request.(PostLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLogout")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostLogoutResponseObject); ok {
+		return validResponse.VisitPostLogoutResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PutTasksId404JSONResponse DefaultResponse
+// DeleteProfile operation middleware
+func (sh *strictHandler) DeleteProfile(ctx echo.Context) error {
+	var request DeleteProfileRequestObject
 
-func (response PutTasksId404JSONResponse) VisitPutTasksIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var body DeleteProfileJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProfile(ctx.Request().Context(), request.(DeleteProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProfile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteProfileResponseObject); ok {
+		return validResponse.VisitDeleteProfileResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Activate user account
-	// (POST /activation)
-	PostActivation(ctx context.Context, request PostActivationRequestObject) (PostActivationResponseObject, error)
-	// Send activation email
-	// (POST /activation/email)
-	PostActivationEmail(ctx context.Context, request PostActivationEmailRequestObject) (PostActivationEmailResponseObject, error)
-	// Get focus session analytics
-	// (GET /analytics/focus)
-	GetAnalyticsFocus(ctx context.Context, request GetAnalyticsFocusRequestObject) (GetAnalyticsFocusResponseObject, error)
-	// Initiate Google OAuth2 login flow
-	// (GET /auth/google/authorize)
-	GetAuthGoogleAuthorize(ctx context.Context, request GetAuthGoogleAuthorizeRequestObject) (GetAuthGoogleAuthorizeResponseObject, error)
-	// Handle Google OAuth2 callback
-	// (GET /auth/google/callback)
-	GetAuthGoogleCallback(ctx context.Context, request GetAuthGoogleCallbackRequestObject) (GetAuthGoogleCallbackResponseObject, error)
-	// Request password reset email
-	// (POST /auth/password-reset)
-	PostAuthPasswordReset(ctx context.Context, request PostAuthPasswordResetRequestObject) (PostAuthPasswordResetResponseObject, error)
-	// Reset password using token
-	// (POST /auth/password-reset/confirm)
-	PostAuthPasswordResetConfirm(ctx context.Context, request PostAuthPasswordResetConfirmRequestObject) (PostAuthPasswordResetConfirmResponseObject, error)
-	// Verify password reset token validity
-	// (POST /auth/password-reset/verify)
-	PostAuthPasswordResetVerify(ctx context.Context, request PostAuthPasswordResetVerifyRequestObject) (PostAuthPasswordResetVerifyResponseObject, error)
-	// Get new access and refresh tokens using refresh token
-	// (POST /auth/refresh-token)
-	PostAuthRefreshToken(ctx context.Context, request PostAuthRefreshTokenRequestObject) (PostAuthRefreshTokenResponseObject, error)
-	// Start a new focus session
-	// (POST /focus-sessions)
-	PostFocusSessions(ctx context.Context, request PostFocusSessionsRequestObject) (PostFocusSessionsResponseObject, error)
-	// End an active focus session
-	// (POST /focus-sessions/{id}/end)
-	PostFocusSessionsIdEnd(ctx context.Context, request PostFocusSessionsIdEndRequestObject) (PostFocusSessionsIdEndResponseObject, error)
-	// Login to the system
-	// (POST /login)
-	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
-	// Logout and invalidate refresh token
-	// (POST /logout)
-	PostLogout(ctx context.Context, request PostLogoutRequestObject) (PostLogoutResponseObject, error)
-	// Get user profile
-	// (GET /profile)
-	GetProfile(ctx context.Context, request GetProfileRequestObject) (GetProfileResponseObject, error)
-	// Register a new user
-	// (POST /register)
-	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
-	// Get list of user's tasks
-	// (GET /tasks)
-	GetTasks(ctx context.Context, request GetTasksRequestObject) (GetTasksResponseObject, error)
-	// Create a new task
-	// (POST /tasks)
-	PostTasks(ctx context.Context, request PostTasksRequestObject) (PostTasksResponseObject, error)
-	// Delete a task
-	// (DELETE /tasks/{id})
-	DeleteTasksId(ctx context.Context, request DeleteTasksIdRequestObject) (DeleteTasksIdResponseObject, error)
-	// Update an existing task
-	// (PUT /tasks/{id})
-	PutTasksId(ctx context.Context, request PutTasksIdRequestObject) (PutTasksIdResponseObject, error)
+// GetProfile operation middleware
+func (sh *strictHandler) GetProfile(ctx echo.Context) error {
+	var request GetProfileRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfile(ctx.Request().Context(), request.(GetProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProfileResponseObject); ok {
+		return validResponse.VisitGetProfileResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+// PatchProfile operation middleware
+func (sh *strictHandler) PatchProfile(ctx echo.Context) error {
+	var request PatchProfileRequestObject
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+	var body PatchProfileJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchProfile(ctx.Request().Context(), request.(PatchProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchProfile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchProfileResponseObject); ok {
+		return validResponse.VisitPatchProfileResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+// PostProfile2faDisable operation middleware
+func (sh *strictHandler) PostProfile2faDisable(ctx echo.Context) error {
+	var request PostProfile2faDisableRequestObject
+
+	var body PostProfile2faDisableJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfile2faDisable(ctx.Request().Context(), request.(PostProfile2faDisableRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfile2faDisable")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProfile2faDisableResponseObject); ok {
+		return validResponse.VisitPostProfile2faDisableResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProfile2faTotp operation middleware
+func (sh *strictHandler) PostProfile2faTotp(ctx echo.Context) error {
+	var request PostProfile2faTotpRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfile2faTotp(ctx.Request().Context(), request.(PostProfile2faTotpRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfile2faTotp")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProfile2faTotpResponseObject); ok {
+		return validResponse.VisitPostProfile2faTotpResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProfile2faTotpConfirm operation middleware
+func (sh *strictHandler) PostProfile2faTotpConfirm(ctx echo.Context) error {
+	var request PostProfile2faTotpConfirmRequestObject
+
+	var body PostProfile2faTotpConfirmJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfile2faTotpConfirm(ctx.Request().Context(), request.(PostProfile2faTotpConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfile2faTotpConfirm")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProfile2faTotpConfirmResponseObject); ok {
+		return validResponse.VisitPostProfile2faTotpConfirmResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProfileEmail operation middleware
+func (sh *strictHandler) PostProfileEmail(ctx echo.Context) error {
+	var request PostProfileEmailRequestObject
+
+	var body PostProfileEmailJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfileEmail(ctx.Request().Context(), request.(PostProfileEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfileEmail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProfileEmailResponseObject); ok {
+		return validResponse.VisitPostProfileEmailResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProfileEmailConfirm operation middleware
+func (sh *strictHandler) PostProfileEmailConfirm(ctx echo.Context) error {
+	var request PostProfileEmailConfirmRequestObject
+
+	var body PostProfileEmailConfirmJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProfileEmailConfirm(ctx.Request().Context(), request.(PostProfileEmailConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProfileEmailConfirm")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostProfileEmailConfirmResponseObject); ok {
+		return validResponse.VisitPostProfileEmailConfirmResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// PostActivation operation middleware
-func (sh *strictHandler) PostActivation(ctx echo.Context) error {
-	var request PostActivationRequestObject
+// PostProfileIdentities operation middleware
+func (sh *strictHandler) PostProfileIdentities(ctx echo.Context) error {
+	var request PostProfileIdentitiesRequestObject
 
-	var body PostActivationJSONRequestBody
+	var body PostProfileIdentitiesJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostActivation(ctx.Request().Context(), request.(PostActivationRequestObject))
+		return sh.ssi.PostProfileIdentities(ctx.Request().Context(), request.(PostProfileIdentitiesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostActivation")
+		handler = middleware(handler, "PostProfileIdentities")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostActivationResponseObject); ok {
-		return validResponse.VisitPostActivationResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProfileIdentitiesResponseObject); ok {
+		return validResponse.VisitPostProfileIdentitiesResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostActivationEmail operation middleware
-func (sh *strictHandler) PostActivationEmail(ctx echo.Context) error {
-	var request PostActivationEmailRequestObject
+// DeleteProfileIdentitiesId operation middleware
+func (sh *strictHandler) DeleteProfileIdentitiesId(ctx echo.Context, id int32) error {
+	var request DeleteProfileIdentitiesIdRequestObject
+
+	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostActivationEmail(ctx.Request().Context(), request.(PostActivationEmailRequestObject))
+		return sh.ssi.DeleteProfileIdentitiesId(ctx.Request().Context(), request.(DeleteProfileIdentitiesIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostActivationEmail")
+		handler = middleware(handler, "DeleteProfileIdentitiesId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostActivationEmailResponseObject); ok {
-		return validResponse.VisitPostActivationEmailResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProfileIdentitiesIdResponseObject); ok {
+		return validResponse.VisitDeleteProfileIdentitiesIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetAnalyticsFocus operation middleware
-func (sh *strictHandler) GetAnalyticsFocus(ctx echo.Context, params GetAnalyticsFocusParams) error {
-	var request GetAnalyticsFocusRequestObject
+// GetProfilePasskeys operation middleware
+func (sh *strictHandler) GetProfilePasskeys(ctx echo.Context) error {
+	var request GetProfilePasskeysRequestObject
 
-	request.Params = params
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProfilePasskeys(ctx.Request().Context(), request.(GetProfilePasskeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProfilePasskeys")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetProfilePasskeysResponseObject); ok {
+		return validResponse.VisitGetProfilePasskeysResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostProfilePasskeys operation middleware
+func (sh *strictHandler) PostProfilePasskeys(ctx echo.Context) error {
+	var request PostProfilePasskeysRequestObject
+
+	var body PostProfilePasskeysJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAnalyticsFocus(ctx.Request().Context(), request.(GetAnalyticsFocusRequestObject))
+		return sh.ssi.PostProfilePasskeys(ctx.Request().Context(), request.(PostProfilePasskeysRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAnalyticsFocus")
+		handler = middleware(handler, "PostProfilePasskeys")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetAnalyticsFocusResponseObject); ok {
-		return validResponse.VisitGetAnalyticsFocusResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProfilePasskeysResponseObject); ok {
+		return validResponse.VisitPostProfilePasskeysResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetAuthGoogleAuthorize operation middleware
-func (sh *strictHandler) GetAuthGoogleAuthorize(ctx echo.Context) error {
-	var request GetAuthGoogleAuthorizeRequestObject
+// PostProfilePasskeysOptions operation middleware
+func (sh *strictHandler) PostProfilePasskeysOptions(ctx echo.Context) error {
+	var request PostProfilePasskeysOptionsRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAuthGoogleAuthorize(ctx.Request().Context(), request.(GetAuthGoogleAuthorizeRequestObject))
+		return sh.ssi.PostProfilePasskeysOptions(ctx.Request().Context(), request.(PostProfilePasskeysOptionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAuthGoogleAuthorize")
+		handler = middleware(handler, "PostProfilePasskeysOptions")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetAuthGoogleAuthorizeResponseObject); ok {
-		return validResponse.VisitGetAuthGoogleAuthorizeResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProfilePasskeysOptionsResponseObject); ok {
+		return validResponse.VisitPostProfilePasskeysOptionsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetAuthGoogleCallback operation middleware
-func (sh *strictHandler) GetAuthGoogleCallback(ctx echo.Context, params GetAuthGoogleCallbackParams) error {
-	var request GetAuthGoogleCallbackRequestObject
+// DeleteProfilePasskeysId operation middleware
+func (sh *strictHandler) DeleteProfilePasskeysId(ctx echo.Context, id int32) error {
+	var request DeleteProfilePasskeysIdRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAuthGoogleCallback(ctx.Request().Context(), request.(GetAuthGoogleCallbackRequestObject))
+		return sh.ssi.DeleteProfilePasskeysId(ctx.Request().Context(), request.(DeleteProfilePasskeysIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAuthGoogleCallback")
+		handler = middleware(handler, "DeleteProfilePasskeysId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetAuthGoogleCallbackResponseObject); ok {
-		return validResponse.VisitGetAuthGoogleCallbackResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteProfilePasskeysIdResponseObject); ok {
+		return validResponse.VisitDeleteProfilePasskeysIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostAuthPasswordReset operation middleware
-func (sh *strictHandler) PostAuthPasswordReset(ctx echo.Context) error {
-	var request PostAuthPasswordResetRequestObject
+// PostProfilePassword operation middleware
+func (sh *strictHandler) PostProfilePassword(ctx echo.Context) error {
+	var request PostProfilePasswordRequestObject
 
-	var body PostAuthPasswordResetJSONRequestBody
+	var body PostProfilePasswordJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostAuthPasswordReset(ctx.Request().Context(), request.(PostAuthPasswordResetRequestObject))
+		return sh.ssi.PostProfilePassword(ctx.Request().Context(), request.(PostProfilePasswordRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostAuthPasswordReset")
+		handler = middleware(handler, "PostProfilePassword")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostAuthPasswordResetResponseObject); ok {
-		return validResponse.VisitPostAuthPasswordResetResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostProfilePasswordResponseObject); ok {
+		return validResponse.VisitPostProfilePasswordResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostAuthPasswordResetConfirm operation middleware
-func (sh *strictHandler) PostAuthPasswordResetConfirm(ctx echo.Context) error {
-	var request PostAuthPasswordResetConfirmRequestObject
+// GetProfileSecurityEvents operation middleware
+func (sh *strictHandler) GetProfileSecurityEvents(ctx echo.Context, params GetProfileSecurityEventsParams) error {
+	var request GetProfileSecurityEventsRequestObject
 
-	var body PostAuthPasswordResetConfirmJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostAuthPasswordResetConfirm(ctx.Request().Context(), request.(PostAuthPasswordResetConfirmRequestObject))
+		return sh.ssi.GetProfileSecurityEvents(ctx.Request().Context(), request.(GetProfileSecurityEventsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostAuthPasswordResetConfirm")
+		handler = middleware(handler, "GetProfileSecurityEvents")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostAuthPasswordResetConfirmResponseObject); ok {
-		return validResponse.VisitPostAuthPasswordResetConfirmResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetProfileSecurityEventsResponseObject); ok {
+		return validResponse.VisitGetProfileSecurityEventsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostAuthPasswordResetVerify operation middleware
-func (sh *strictHandler) PostAuthPasswordResetVerify(ctx echo.Context) error {
-	var request PostAuthPasswordResetVerifyRequestObject
+// PostRegister operation middleware
+func (sh *strictHandler) PostRegister(ctx echo.Context) error {
+	var request PostRegisterRequestObject
 
-	var body PostAuthPasswordResetVerifyJSONRequestBody
+	var body PostRegisterJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostAuthPasswordResetVerify(ctx.Request().Context(), request.(PostAuthPasswordResetVerifyRequestObject))
+		return sh.ssi.PostRegister(ctx.Request().Context(), request.(PostRegisterRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostAuthPasswordResetVerify")
+		handler = middleware(handler, "PostRegister")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostAuthPasswordResetVerifyResponseObject); ok {
-		return validResponse.VisitPostAuthPasswordResetVerifyResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostRegisterResponseObject); ok {
+		return validResponse.VisitPostRegisterResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostAuthRefreshToken operation middleware
-func (sh *strictHandler) PostAuthRefreshToken(ctx echo.Context, params PostAuthRefreshTokenParams) error {
-	var request PostAuthRefreshTokenRequestObject
-
-	request.Params = params
-
-	var body PostAuthRefreshTokenJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+// GetSessions operation middleware
+func (sh *strictHandler) GetSessions(ctx echo.Context) error {
+	var request GetSessionsRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostAuthRefreshToken(ctx.Request().Context(), request.(PostAuthRefreshTokenRequestObject))
+		return sh.ssi.GetSessions(ctx.Request().Context(), request.(GetSessionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostAuthRefreshToken")
+		handler = middleware(handler, "GetSessions")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostAuthRefreshTokenResponseObject); ok {
-		return validResponse.VisitPostAuthRefreshTokenResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetSessionsResponseObject); ok {
+		return validResponse.VisitGetSessionsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostFocusSessions operation middleware
-func (sh *strictHandler) PostFocusSessions(ctx echo.Context) error {
-	var request PostFocusSessionsRequestObject
+// PostSessionsLogoutOthers operation middleware
+func (sh *strictHandler) PostSessionsLogoutOthers(ctx echo.Context, params PostSessionsLogoutOthersParams) error {
+	var request PostSessionsLogoutOthersRequestObject
 
-	var body PostFocusSessionsJSONRequestBody
+	request.Params = params
+
+	var body PostSessionsLogoutOthersJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostFocusSessions(ctx.Request().Context(), request.(PostFocusSessionsRequestObject))
+		return sh.ssi.PostSessionsLogoutOthers(ctx.Request().Context(), request.(PostSessionsLogoutOthersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostFocusSessions")
+		handler = middleware(handler, "PostSessionsLogoutOthers")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostFocusSessionsResponseObject); ok {
-		return validResponse.VisitPostFocusSessionsResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostSessionsLogoutOthersResponseObject); ok {
+		return validResponse.VisitPostSessionsLogoutOthersResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostFocusSessionsIdEnd operation middleware
-func (sh *strictHandler) PostFocusSessionsIdEnd(ctx echo.Context, id int32) error {
-	var request PostFocusSessionsIdEndRequestObject
+// DeleteSessionsId operation middleware
+func (sh *strictHandler) DeleteSessionsId(ctx echo.Context, id int32) error {
+	var request DeleteSessionsIdRequestObject
 
 	request.Id = id
 
-	var body PostFocusSessionsIdEndJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSessionsId(ctx.Request().Context(), request.(DeleteSessionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSessionsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		return err
+	} else if validResponse, ok := response.(DeleteSessionsIdResponseObject); ok {
+		return validResponse.VisitDeleteSessionsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	request.Body = &body
+	return nil
+}
+
+// GetTaskViews operation middleware
+func (sh *strictHandler) GetTaskViews(ctx echo.Context) error {
+	var request GetTaskViewsRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostFocusSessionsIdEnd(ctx.Request().Context(), request.(PostFocusSessionsIdEndRequestObject))
+		return sh.ssi.GetTaskViews(ctx.Request().Context(), request.(GetTaskViewsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostFocusSessionsIdEnd")
+		handler = middleware(handler, "GetTaskViews")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostFocusSessionsIdEndResponseObject); ok {
-		return validResponse.VisitPostFocusSessionsIdEndResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTaskViewsResponseObject); ok {
+		return validResponse.VisitGetTaskViewsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostLogin operation middleware
-func (sh *strictHandler) PostLogin(ctx echo.Context) error {
-	var request PostLoginRequestObject
+// PostTaskViews operation middleware
+func (sh *strictHandler) PostTaskViews(ctx echo.Context) error {
+	var request PostTaskViewsRequestObject
 
-	var body PostLoginJSONRequestBody
+	var body PostTaskViewsJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostLogin(ctx.Request().Context(), request.(PostLoginRequestObject))
+		return sh.ssi.PostTaskViews(ctx.Request().Context(), request.(PostTaskViewsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostLogin")
+		handler = middleware(handler, "PostTaskViews")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
-		return validResponse.VisitPostLoginResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTaskViewsResponseObject); ok {
+		return validResponse.VisitPostTaskViewsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostLogout operation middleware
-func (sh *strictHandler) PostLogout(ctx echo.Context, params PostLogoutParams) error {
-	var request PostLogoutRequestObject
-
-	request.Params = params
+// DeleteTaskViewsId operation middleware
+func (sh *strictHandler) DeleteTaskViewsId(ctx echo.Context, id int32) error {
+	var request DeleteTaskViewsIdRequestObject
 
-	var body PostLogoutJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostLogout(ctx.Request().Context(), request.(PostLogoutRequestObject))
+		return sh.ssi.DeleteTaskViewsId(ctx.Request().Context(), request.(DeleteTaskViewsIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostLogout")
+		handler = middleware(handler, "DeleteTaskViewsId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostLogoutResponseObject); ok {
-		return validResponse.VisitPostLogoutResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteTaskViewsIdResponseObject); ok {
+		return validResponse.VisitDeleteTaskViewsIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetProfile operation middleware
-func (sh *strictHandler) GetProfile(ctx echo.Context) error {
-	var request GetProfileRequestObject
+// GetTaskViewsId operation middleware
+func (sh *strictHandler) GetTaskViewsId(ctx echo.Context, id int32) error {
+	var request GetTaskViewsIdRequestObject
+
+	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProfile(ctx.Request().Context(), request.(GetProfileRequestObject))
+		return sh.ssi.GetTaskViewsId(ctx.Request().Context(), request.(GetTaskViewsIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProfile")
+		handler = middleware(handler, "GetTaskViewsId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetProfileResponseObject); ok {
-		return validResponse.VisitGetProfileResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTaskViewsIdResponseObject); ok {
+		return validResponse.VisitGetTaskViewsIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostRegister operation middleware
-func (sh *strictHandler) PostRegister(ctx echo.Context) error {
-	var request PostRegisterRequestObject
+// PutTaskViewsId operation middleware
+func (sh *strictHandler) PutTaskViewsId(ctx echo.Context, id int32) error {
+	var request PutTaskViewsIdRequestObject
 
-	var body PostRegisterJSONRequestBody
+	request.Id = id
+
+	var body PutTaskViewsIdJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostRegister(ctx.Request().Context(), request.(PostRegisterRequestObject))
+		return sh.ssi.PutTaskViewsId(ctx.Request().Context(), request.(PutTaskViewsIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostRegister")
+		handler = middleware(handler, "PutTaskViewsId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostRegisterResponseObject); ok {
-		return validResponse.VisitPostRegisterResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutTaskViewsIdResponseObject); ok {
+		return validResponse.VisitPutTaskViewsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTaskViewsIdTasks operation middleware
+func (sh *strictHandler) GetTaskViewsIdTasks(ctx echo.Context, id int32, params GetTaskViewsIdTasksParams) error {
+	var request GetTaskViewsIdTasksRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTaskViewsIdTasks(ctx.Request().Context(), request.(GetTaskViewsIdTasksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTaskViewsIdTasks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTaskViewsIdTasksResponseObject); ok {
+		return validResponse.VisitGetTaskViewsIdTasksResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -1644,6 +5676,60 @@ func (sh *strictHandler) PostTasks(ctx echo.Context) error {
 	return nil
 }
 
+// PostTasksBatch operation middleware
+func (sh *strictHandler) PostTasksBatch(ctx echo.Context) error {
+	var request PostTasksBatchRequestObject
+
+	var body PostTasksBatchJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTasksBatch(ctx.Request().Context(), request.(PostTasksBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTasksBatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTasksBatchResponseObject); ok {
+		return validResponse.VisitPostTasksBatchResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTasksTrash operation middleware
+func (sh *strictHandler) GetTasksTrash(ctx echo.Context, params GetTasksTrashParams) error {
+	var request GetTasksTrashRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTasksTrash(ctx.Request().Context(), request.(GetTasksTrashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTasksTrash")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTasksTrashResponseObject); ok {
+		return validResponse.VisitGetTasksTrashResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeleteTasksId operation middleware
 func (sh *strictHandler) DeleteTasksId(ctx echo.Context, id int32) error {
 	var request DeleteTasksIdRequestObject
@@ -1700,63 +5786,228 @@ func (sh *strictHandler) PutTasksId(ctx echo.Context, id int32) error {
 	return nil
 }
 
+// PostTasksIdRestore operation middleware
+func (sh *strictHandler) PostTasksIdRestore(ctx echo.Context, id int32) error {
+	var request PostTasksIdRestoreRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTasksIdRestore(ctx.Request().Context(), request.(PostTasksIdRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTasksIdRestore")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTasksIdRestoreResponseObject); ok {
+		return validResponse.VisitPostTasksIdRestoreResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+wcXW8ct/GvEJsCaYG1TraDPgjog2LLiVqlESS5eXCEA7U7d8dol9yQXMlXQ/+9mCH3",
-	"m3u3d5ZkB+mbbvkxw/me4VCfokTlhZIgrYmOPkUF1zwHC5p+nYlc2HP8hL9SMIkWhRVKRkfRv8v8BjRT",
-	"CyYs5IYVoFnBlxDFkcDx30vQ6yiOJM8hOooy3CqKI5OsIOduuwUvMxsdvTyMo5x/FHmZ4w/8JaT/FUd2",
-	"XeB6IS0sQUcPD3F0zpcwghUOMUmojSDicQzhsQXwQxxpMIWSBog675S+EWkKEn8kSlqQFv/kRZGJhCNG",
-	"s9+MouEG3F80LKKj6JtZQ/iZGzWztw6VCw/Fwewe8DhJwBhm1S1IJgzLhTFCLpnSTMg7nok0eoijKxw+",
-	"0VrpCbjBR54XGeCf/swnHwuhIaVdcLtp2LeABhA/ddghouC2d4cgjvotEMJxaVe0k5NHrQrQVjiKczr8",
-	"3K1r0DVWC7mMiD8LDWY1OuOhZqu6+Q0Si2veaOAW3qmkNJdgjFDyAn4vwdgh/BsN/Haelpq7Q/Wl73sc",
-	"Z9U4E5IZSJRMTTQQpzj6+GKpXjRfX79CZCw3t3ORtlDftkDkoDeg9HZvZIicv5fIqejoQ43ZAOT1KE2v",
-	"uLkdpWUHzQArQaZzhISDC6VzbqOjKOUWXtDXOLDCWJFzC826LilOqnGG40iQXMjSwnTuOBMSQLbQQmlh",
-	"11tVhJvb82ouyr3l2u54TGO5Lc0USJduZp+TdIoWzvWWIU72TdKAjzkYgyZ1mrKdyHSSpi1w0gaxPsl4",
-	"YSBlNC+kcWyBhobrbM1ApojPZKkfIE0YH0uera1IQmZJzBcA6Q1PboeDIi+0uoMcpJ1zDc7IkcsMSpL/",
-	"wLXmJCG5suKOj2qJsRrk0q522jV0xpSLbE2SODdF5SzSVCBknp13jtT3jH1T/xMvMC5AKUZPVbPEbRwA",
-	"TrbFCeE8UaWPRfYF/wZ3QARwW4MyATxZMS/kIfDK8my+zXxc4SwGAyOys4knaF1KhyDR/jRjL9sdlmKv",
-	"d1/CtSXkE9I5t9Nt3WebgcnY7eJzp1ngNr0rS/xVOfg4Kot0Z5aUBvQOB9gmiZc1LUFi4P0h4okVdwga",
-	"aZqBBYw5QKaQzsmet9yUx6oLuEH1nC+FJOKMuy+Xl+yS3QzNT+H9X88MlVqj8hadlGS4muzBmBGQXTzG",
-	"N5gjGLN9GzctmFYN+HQBS2Es6DqRmOr5q70apr4tXd4BJzkXWRRX6UDv5zk35l7pdMjjIIIY5Azx2sfQ",
-	"/BGj0V2syB80cv1iJqpz3JYc/yiWqyiOfoJUlHkUR2fqfro9ap2steWVShVpADvXaqnBoAS8adk+n43v",
-	"AKeT/e+vtF4pXRkg7lYFpinoe+Le//PAryYPHHLIgH4cEwpkykNU2aSKvip3+nbUypk5RQSIz5CXv6zA",
-	"rkAzuwKGav+tYYQHE4Y1y2psb5TKgMsQKZCikJTIkUuknQ+LgWvQx6VdNb/eVeT45y9XVSWRNqfRBtjK",
-	"2oICX6VuBVR7UE3SfWqKkp3CVpOuFeJfsHbFNCEXiqgobIZjl7ZM1+w84xI0Oz4/jeLoDrSL7aOXB4cH",
-	"hwhaFSB5IaKj6DV9wljFJ4szT54qG1BOOVEK6ONpGh1F58rY42aeqyOAsd+rdL1T1bMrXnV5rl/brCD5",
-	"+qbB+OlOcMfTLaa+uxfKNSOp2qPWVe0ae0yHhZHuCqtL6FeHXx0ehk5I6W0jmsyUxPpFmWVkCb47fD2m",
-	"0vX23UprHJkyz7leNxR0usC4g4bH4EtDsTUK4TWuabF/VmvuFCGoIrYph63Z6XSS2Dk8cGApAWE808DT",
-	"dYtaSjOrFMu5XDMvimYy1Zp6fVvZo6MPXTX/cP1w3abpJciU8d5ZRmha1YhmlJAiSksIUPQHsHU5iZIh",
-	"Uszm5uXDp+DVhfMSaIQ7FxhTzPNDHN4Svet+G16HReBR7kF6BbfAbQLNYMblkIw3U59WFn4A62sNQ9At",
-	"iai/ebEo7Wq2VGqZAf2ttPgvtISje7QLSIWGxJraqTGr2A+0/FvDMrUUsspFh2JV2pWbelwD6jHqtaNP",
-	"GGgDqgtoBTytLgVVMlKK8At/RtCvWHVSpzXvL846MjYMHi/Bvnjj/OIwl768eOd9Qu06x/d66BrFUyms",
-	"QKPYxc+db5Gp+xFlbnEt4VlWlXeDTPuRyzQDx7JqMltolfeAcpkyF1yZWaaWVJ2s2LyZn28qFAamomd1",
-	"O2RPVAptPEYuRXFa1PdoG9nVB4sxJrAaMyq/E9cKrSwkPnwYsWp2N+DbTY+Fj3a2snnWtTn1TSdRCaSl",
-	"kkTK7oVdVYzq+Keh2RtYIgo0MrVcQkoluJ57e334crO2LTSh7ZFAoXOCTqLhtGaS8r2r9nl/cTbYq6Mu",
-	"DRUwQjVHs9k93Bhh4SBR+TftUPQfBwcHv5aHh6/+3rlYxc8h0jTe/Lluw6tLZT4UeqWp4g8sFybnNln1",
-	"QiWnsT31TBolG7MIhS9RvdBgnCXYEDWVdlWVtC5o+mNF0GPJVi+QddMeLXytzsLo7DtHdRW3HFZddvj6",
-	"ACsCICZzY5YouRA635Erb/yqx2KOhPt5hVigtAz3zSnpdsyGMpuRHIkQ9q5QQwLiDtKvIUWKu6d+KpFr",
-	"5GxUyuoVJGzOIiy4yCD9/PzKUb/mXkmtN3XWPk1G70CLxXpHEf2PW/TECfjXKlyPJk1XVdtU3Sn1efLg",
-	"2NI3Wo6ABML1V4xJhveqL2p2VBLRw3olDAOZFkpQ7SCBwmcHfgMPkeI8EHVBzMsKu1HpGv2hC50PfpWn",
-	"C3ajMELQwArEWdp4uMLyWzA4nkAKMsGFgxC1EtYLh0hVKt4YoV50kDZWaRc61ZF9sETWbe3aGh4+hpZM",
-	"6Sbz5bj2+atSd0BoH54waW41zgUiJfQ6Pq5cgkQW9otPnThzUzLW4d+WRKxWsK3NgB1R7qkZpt0S7pmL",
-	"TSmJ6kw33hLrHmYBvaPs/YXP3s1mK9y+pzafYXs3cW28+3CSjXv5uDWXqj1ka8XFXxDsFvcJWZSWanjc",
-	"3DKpqLGlqC699ijd4IrvAvay2n6hSpl2AN5Apshlu4x7t0Kg5doyTpLYKQK1JM3V/UKiNvsk0ocZyHQH",
-	"mTtNT2Q6UhssuF011pGc5dYMerMPvn4aCR9p+QuI2M8yWzMhk6xMgdwRuSGxYGtVsnsuKV/GFBfHKkmk",
-	"npCYKfR698LgAJkH58gwEValZcJGD09dsJysPNTMsqfqVHsIQ/Lsm2UeT3f8Ibrq87macyJTxqVHdpLu",
-	"UHFus6ac0ZSnTqhR+ZpMbjwAIGxO5UJF+8eqk1HnWfbzgqg+NSCIB22pz3Steh3QBld5rSjwdIHHthJE",
-	"nZkxUyK9MUPsxB3EVBR5MjhrYyEPRxWZWqrS/mEj+DOH/p8wbncn3z1i7xnNlimvasGOoBMl+y1kYPvC",
-	"MOmmY98A+xlQ66sS+mEM3f07Jd7fNaxahVYLkcGmu9RzP+UJHfx7A3q0+u9RZEK6m1MKAp7+IrJswW7R",
-	"jtyzo532DZybHWnV5vnFfemwct1acd1Oth3C+7vbl8+UdTsG+AbtYO1y+tu8unOv1x0BH4Wxrd61Xqvt",
-	"5Od73W7fwGEu2odpFVcBF5hBpdTt5vMlf78Z0G96pLFJu69owsA3hY7STJk1b0Qx9tkyufXONXCzCVwn",
-	"K2ZB53RfJzI8lntbcrNm6ObQzLYXjVx00kbRTteq73rQ6kcsYzepZfeub3pr4DbIrediwWe1rddkk6E3",
-	"LZCTTq4tvSlif6Uc0Yg7+Ns4JbZ2yoSbZDYjganlRBS2d9ZMQwAyd0uktGU3Y9TH0fnNOvyuud3NGdfN",
-	"vZ2Prf7TVsdtq5/1egKul4ii0uno02vCspoQQhT3a6HI6Rd9vH78BqReGzK3vPOGbpvshh7rFfWDk207",
-	"BJ6mhHpSBzb4TJjW0za65m+Ashwsp4NMKic8R4ySeXx9Emm9La/8gPt9jaQbDU8q+/901dd2f/ozhw9O",
-	"koZ8phLmliLrczdZPIvQOI74qAHFIyAtddhANVUn5ZikDOXHJS8kQafPXEjtCMxYkdrhvWcXcKiY91wy",
-	"0S2x78Zin1HyMfbGUVGGbEFpvwgjH9/qDF/F7H2fjWzwT6T+XELkaMi4dPkP9WGMWAvaVt9VslLqzLe+",
-	"Hc1mmUp4tlLGHn13eHgYIQy/PtRX6TsG0dXWsmlarzgQ02FcRDWC0HxXSI+DTM255Ev6rwHBpe5wgXix",
-	"c9EQWumq7MOVdbt1+GhNh/X1w/8CAAD//wAbAfA1SAAA",
+	"H4sIAAAAAAAC/+x9+3PbONLgv4LSXdW3ex/9SGZ2tz5XXV15Ys833kkmPtvZuap1SoLJloQ1BWgA0I42",
+	"5f/9qhsACUqgRPmZzOQ3WySBRqNf6Bc+D3I1mysJ0prBwefBnGs+Awua/nujCngz5WUJcgKn+Ah/LcDk",
+	"WsytUHJwMDh//Ze/stOf3xyzPLzJroQshJwwOwUGn/Ipxx9zVQCzin7k83kpco5DZEzDb5XQULCx0qwA",
+	"mLNSyGuzeykH2UDgHL9VoBeDbCD5DAYHAxxpWM82yAYmn8KMI2x2Mcc3jNVCTgZ3d9ngTaWN0h3Av5/z",
+	"3ypgOb3DNNhKSygYN2wk4ZMdugcjdrUgsOcaboSqDJvzCexeyl+nIJkBm7mnnFYprZAVGMbHFjQ98MNz",
+	"WbARvjRiwjAxkUpDsXspD8MLwjAlywW74aVw2MCvDZ8BM0pbpnQBmgnLbrlpgL0VdroGVzT0BhwdQSlu",
+	"QC/eqaJrm39StwSNBlOVFkEt3EdQJDb1gM2Vsc0jNQcJmqkx42yu5tU8u5RXC8aZhkJoyC2+yNlYK2lB",
+	"FuzD2duMKc3onZok3PczdSVKmq171TNVtOmigDGvSjs4GND0g2wAspoNDv5Z/x8gGWQDnHCIEw4+Zgls",
+	"nci8rAq4UJaXHdj6dQp2iruvWK4qaQkJFj9gsppdOVQICzNDVIFEYTqWItxsQ/o6vaYxLw3UkF4pVQKX",
+	"BOpbMRO2A8ZflgCZgyZAOuAocaj0/K/2s8GMfxIzxOirffxPSP9fDZaQFiagCaxT3ilO8JHHUQcgHsYU",
+	"HJsn1upGFNAlDn5BVlNjL7csaMlLNvffZMxU+ZRkw0SpSQmjAOCc22kEn3+faMpJtsGB1RWs58Ez4ucL",
+	"1SWptJgIGYBr+An/cxzFeFmqWyiQaUaHb9++/3X4/uzkv09+OR9lTNE4vGS3KLI4M0JOSmDKjSpM+Hj3",
+	"Un44e4t0G3Fme+j3hx8ufhqeHR+dnB2/uRh+OHtLE+hlPqXFQuLTo+Pj0+Hbk19+Hp6/+en43fH5qJuR",
+	"nZQbWrVBgp1DXmlhF8c3IO3FYt5FXu9RvtL24ovGY9AAwyFNxmB3sstGpZoIOTRVngMUUIyc6Ha/jrko",
+	"oRh1wEuQxaASbyVgrsmTa80XtIYPBvRJUQOeIC1R9CGqQPDZ4NPORO00v373enCHE2kwcyUNEFyHOUmo",
+	"I2H4VYmjokkgLUiLf0Yyfe9fBlH4OZrwf2oYDw4G/2OvMSL23FOzd+S48szP5WZub8YFKg03Pam0Anhu",
+	"xQ23jly4ZLyYCYnI+lHpK1EUIJ8TvsM8B2OYVddAPDITBvkGaV1IUtII2gU+PtZa6R6wwSc+m5cQ7dbx",
+	"pznuJo2Cw/WDPpo0AfiJgw4BBTc8s2H8C6Xecbk4g98qMNb0gPnx9lspNuNywbSfvLZxtKosZKj/Z/T/",
+	"ySnjRaER+84KwB8rQ0Q9BV54+/QMrF7sHKKhlTBMIVeywO1jt1xYdgVjpYFGQtsuADFYw0F3d7QKv0Ti",
+	"FiTIc8u9tazVHLQVjpOIeGFowBihpElB5J6w26nIp2zKb4BJZcMeDVZVVhYGxbUnRkSRYcgCRIHrhkc8",
+	"FUxIWmnJjWXf7bOCL0xy/LHKK9OCuesdK2awCgFZQYzeYPgGTmwc5pPzWW6uEwu5wJ89Xrh2aPFLsJqb",
+	"aXKsGimJfUAhkl4NyZSOlRaRFGwDeNQhm1JwzYGOP8MCSnCfp+bSkHuma8/0RgPN0ncHnWGYIt5YV/xz",
+	"EAzIBj3RehNA15iqQW2sYXX1L8jtyhRuQ7IVTlgi40AFK9TXIrXV2TLHfkj09+C+xt419+DD3O3KkNOO",
+	"jZWe4V+DglvYIXCzVQ0fUDlEAVJUZf35ymHBbXWtC0VZsitgoLmBImP8yoC0rJIlGMOENSyM7E+CJMgI",
+	"8J6A+W3vCU5bNa/Cw4TZZup5yRdDZ9EkzCKYcVEmn4ii28hxww1OjtJWTzYQZtiSCx3nNa9m/sMwgoMs",
+	"44hflo9Y2UCrEjapxjN8Z5lXnC2HT5aAW2WfJCdUdkpGQFIA5mDM0Kn8FCY1jDWYaecbd4kJf+A2n6KY",
+	"fj8HzYNYa08sEqg9OQrHFGR61MbVHCnEnRhKQL3vfSFkDjhGW+XBjn1V803YXwUczwdBF236+gMBi597",
+	"i2llI9U8uUEd0x58rj0P9UodQgZeYEDC6xANF8BYQf5VVV4P/VCbUFKV1ziWW5xDowfStA4t2+EVB5rx",
+	"Tyfua3IErBxyuvFUW44rK3M+p3tAdkYfDu62hQM/WoECgonfIZi6yJ6Px5CTLwzJrS9dC1nAp4R7RBmB",
+	"f0aOAIf7YC54ZZAxJIfAah6BLFd4IK/9kqUgH1209ynN9xAOM5bbatv9Oncf9dml83r8wFT1oR01Eh3V",
+	"nZhFbXfF8+s0c7X5YWXrnQfb3ENWIHGYlB9gw/YvsdFMyPBvgpbbagV3MQCcEkzOvvwRDS1/IOkWKRr4",
+	"9bCoGnnfpsYf8DkrqoYEvenvHOLeMRdoFRWr8z/5g3TGxJhxuejNFMhBw3VmwMoHYgZ6zQKO7g+60uz1",
+	"X9hMyMqC6bmCZYvcL6d7l9YK/NZSUpaULOpDWz/jDIwVMzJz04e94/C8Puptt/7gyEoAO9dCoQNvo+OD",
+	"m+vT8K6TMNpuucx+UglnikRRvHG0igjmesjUTi47Rlb2cQbG8An0tMOOZdGLd91Jqpv0j0s+N1D4o3uC",
+	"h8kaA67LBXNHw/5EvgI0QXwoebmwIk9ZrGI4BihIQK88FDN0qcMMpB1yDdxs41fNBjNFlnUXlxirQU7s",
+	"1GzprV1ZY8FFuSBKHJp5cKcVhXCO99PWkhKeh9bmvONzFD1IxeTAClviBk5MTqLEEeGQTm3mIdO/wRFI",
+	"9pFbRkgGPJ8yN35yevQtDDeJD+crghUh0ukv6pLqNFsb06mZaHx6Y/tZOqnY890jKsve676PD+LBYqA3",
+	"dNvo5X4SOMZ3kMTPYwT0XrQzsLfbEjQmtljAJkpctYGd/2CQUW5HCc6pALKAYkjyfNUGbk/cgPp3o+Sv",
+	"cPUzLM4hoWOuYdEWm2mB4+JUbaT//fz9L+xXuGI/wyJj5GEaC+mcq2c/vmF/+8urvyUlzTrjl+BJ6eC3",
+	"Ql5DcVKAtN7GWDLw78FZtaNqiaHwZzYRNyCbtBEXEWYqculSNDE17jakjSOhU3U7yAM8PS2OtwhpbL7w",
+	"snw/Hhz8cz33Rh6qu2xFpz+RO2425sOGItaNS/hnEqAwjHu+Z2OeW6V32cmYGZUxqRiv7NQFzwwFJkLa",
+	"TXYpRzgZPRqxWWUsu2qyner0nRnjlo32aLa912PuY91pyGt/3FLcaKq03cFsGx/HcxTlE6zm3JhbpQvy",
+	"096AFmMRI6d7Zz/eZYN3Y45JXp1WZK6KlCJ/f3FK+Vx0DOJMQ64wf4h+w3OSJu8xzG0HJDHT0hQppj3l",
+	"EyFJMnfbzi4rZZvcllXbJ8r0SkRj6PdwDsRXaaDaCR6zdHuGiN+8db8yssYR5k3GSz0qHTXnfv1ygoKk",
+	"TuRaEwFKGUFL+UaZSzGba2gmGrVyjCg7zYDtnmro8pU2TkivPWjCuyRZGHMNjyTEn17Ydpx416zs0BjQ",
+	"DqWJ2CDpMF42+X+YWCP5jZhwFF15/YbZnYD9059HGdo1whpGKteAFrwU/+Y+xrfZgf8DN/DX7ytdMpDI",
+	"qwVr5mAUcEnEF7r4FcUpfpojsEfc8h7zpWbISwHS4gC4qnsOYsREcltpuOf3qJp+4rIo7zfAshxsLylL",
+	"4CoG+WOncfR5w0Q+i8hv0cc1hGgtGMsfgxQdb96LGrehrgbg924xL0ZdGzd3BdRn2ND38zrEs+LgdsnU",
+	"XSYIGXIundUdKVxiM2iYKbmgDBTMElRyQn+7p/X+p3CsGljWnRpWEw6Si0MDqM7B6utea/AbTk6/Ar8O",
+	"ww0o01dpDbmNfvtF1f+kQgk3QpVNIK2NxLOqBFMnbfpR2FyVIncHBQm3ze/kTzCDrF/MKwD1jzB/P6fV",
+	"6mcrUB8yXZXQAfbBpSzJfYZsnU+55rkF7bKarxYW1X+upNWqjJ5mzd8sL7kxYLJL2fiFgksuo2GupbqV",
+	"hI18CkUNgK8PCDtnlRoatJMHGf2NpIi4c5lww2Zy/3wMt82PQw+Ff3YLHAN1YcrkPp9qNRYlNBGjJeno",
+	"AjA11tzbLrYwFlAWcbJTyCvEv0sYW1ZJf4rYvZTHs7ldMDexw+u/QavaU2NYXgLXzcAOLUty8YZbroeV",
+	"TliJh1dGlZUFNrV2/ifzZ8y9r8EWOeqaOAqSznMhD/fwAeEiyvz76z5KmFfff7+/39sBE+be5Oc6etxZ",
+	"l7JYZvzTWyLZdti7QVGpcp40E96csu//xkouJxWeAyyfhCRkkDsfzkcpfFsxg38rmRju5PCXQ+f2xOdh",
+	"pOMKqWHvlGthkgPeApD3WNthwRerw/4otLGYgRYoAT/w6Nunk+55JfGxVeyv7n80UnCsqDLgr1F6/v79",
+	"vbBnMBHGgn4EcX9UuWxXIKcNCXwSF0v/Pp3AXxL2mTseUUZVnWL8tArgTKXIEn+NuT5z2Y6G5VwyXhqF",
+	"v7YTEAzSLjo+RvTqKJLNXm7Q70kctrL3H+dgV4DlotzSKXkEc5CFCYd6HNTzEP47moGdqmLkyhuc/8jn",
+	"L480cKOkf+TyDpiSkPJgPiyNbT70idFrCXzJeFvM692kuoeolEV7boJilLGRT/lrfHP443I1RLZUCuH+",
+	"V5UdoVOslVs21MqGYQKtDzUYsMM6a3H1WesXrwlpbOEduFSchXUZSjcwRymKo0vZ6XjnE09j6/zyKyoS",
+	"pHXx0LpsxCVnhiRJp5k9js3CWJjdLydA1GeJlB3fGXxqs0hHQicuEOl2QtnFvVM2c+eoWudORXFl3Nod",
+	"hAEVPC6h8L81iferXtACbkQOtV5dkkrAC8xbZTKq03JfZKwATQ5SUkrCGrdat9uJRblcX/NkzqINjLrs",
+	"S+rYsYBNdO3iJ8xz1xa5vmuJPqURLnxW5GPI4BKabzqWSFmhuL6ZumkKRkPSf9+Jvr5MmG2I6fGzZqSY",
+	"z8F21McFXy2Zylzn05BAuMt+unj3loHJ+RwKDHaAntdJUjPMzUOMwCebXcraD9D8rmeG3Wo+n7vyhtFl",
+	"tb//XT7j+pr+ghHaEGY3Lb2fJ9PnxUK6rS2KbNSfxAQZ4R0UokKd8lbd9o/f0sqUtj/iyXA195gW2cJs",
+	"xCut/CYNJdxwmcP2c7/XPtQY5uYmHzie3XKwlUD3hSoUmensVKsJCdts8CYKevviuu3m+YeA2/5hzvBF",
+	"k/L53HGJ7Qk2HQtcXsmKDkDSSLs7XFktJQu5XDGMeird1NwJQ8lMmetm4GAk+zOcOi2M0mraBYruw8iP",
+	"LzVJGCaHNErb4VWvERt+DN+pwCF9PnXsVMvDjftBrzU7ErK//YbssmNBNhye64InCB80J4TX+6//srP/",
+	"aue7V2iD4xDuBQ14zrype3qQImybeAdsJNUtmvNWFXyBf6DnYMT+RFDF3oQ/09CjmZJ2uvycfvxzxsaq",
+	"qSVX47EBS7lpU1WhTxEL5DIai4pG6aO6opvm/8+/+SMDvvSfr253XhUYjj+iLDsX3DeqvGlq70bB0zJq",
+	"H4Y/XLxBGLzvybkp3cQebvpc+Jkaz8qoW7U9QkJq6sDQLk6+v6fEe0JouEauun8/Zr3MSmXnxxIz8WfJ",
+	"Q76ycwx4DSstEnWuZydIZlfAzBS9wdwwzv7vGeUbJBEKuYaO6M93r+tApnsto5NdK9rG+HwePLQ5l1JZ",
+	"ZtD1gSTw4exk0GvFq+UA31K4XzSFe3WH0pWkT+stv99J6oEe9gCUS/OsC2AJ9b6HkKps22X1dJ73p4Dm",
+	"pepKnUsqxc+Dk/oZLniloYxhzo1V909yrqS+Dt+lnMZE5vtTJdk9ajCjfwHtHzvw4bQaucnPES0+6Ry4",
+	"Bo2pls1/PwaR8vdfL0J3C9pBetqgAUUYCSSlrgWEMYQcHPifmsYzrYrihtDm4mdYuE4fQo4Vfm6Fxf0c",
+	"nNuqWLDTkkvQ7PAUVeYNaOe8HLza3d/d92Wnks/F4GDwHf2UUcMbWtne7i2U5Q5Ff/f+dXttdkM/kklK",
+	"s/8MC2fDOYYcC2cmkhV3LYoRc21Dwj7SStDTQN85RR/K7ql/hrOTOSMHNkovHHteXZXCoC+jPtjAgp7g",
+	"N864qwMiJ8XgYPDfYH+FsvwZV/H322uDCdWDpQ48r/f3H60LSzthO9GDJc67Zu6dbGCq2YzrhYPX6TJc",
+	"ac4wodrhwyqXW+pQKoypoGB///XCdXOYGDrYIwl9xAH3vAAJvmrljJ82bk6VsYfNe86iBWN/UMViK4S0",
+	"1XdHBkszk/dGk4vrRnAn9TZ4dVbbrrRS37bx8IdRs4FdMp9rVr9b7vJ0lyaZ5RW6fg1NoxKK2RgzrsqS",
+	"FML3+991EVA9fLu1UEwbHoM+jtBoq7Xbv1fr3T5EEAKvfRZbb6fTWrSdqwve78rQ56UGXiwibGEsb7lD",
+	"UW+sNQ2q8IvX/9UHz+1GTLGEJ69TLNv/+fHuY7wX5yALxpdw0LEXGHbdCyPvuAhWpxw97g5waWDXMLe1",
+	"eeaOuO5E7MOtdBJ2uW6YuhL3WPNRsSb0iL/hHN5kDvNkoR9LM05KqrpGTHHc2AyyVvvSf35Odmhr2G/L",
+	"xmlZerwo0LO2RV2aHBqA9zpa2PX4smmn2OPlqCVkj7fj7qk9Xl9tjYlk+yBtt3Rg9inDvSzkFkZTBvK8",
+	"rjDYnF2xUouQstFW1O1bYciN5ePGFBNpZmUzsJyW1Miq52rGFnrE+bqCRs59Od31spBeQQpHGGrS1HQF",
+	"XCsvQ7bJ3ceUecOrQtg4mE/VK+HEHmSoHyESopa3RGeHVKK3ntDIi2ZJ4PWDcX2IUZj+wba1ipZen9im",
+	"qoxTMjo3t+4ml9SLrtMeZWi6ril1r8KZorh8DtKWC9++qNjt1Fk00KqqWu6C23i/nXmjNPMuDsp+yFjO",
+	"DTAhDUgjfLlnSj39tkkrpT7yban60UTobrW8hh1MzUHIRgcsbh4mCybBBR3ihp/o/vc96Or2Zpdyh40q",
+	"Wb80OvDYQIrJlRwLPYOCLcDSm6Gt2eigq5Uovbbc6Y5ed382vdQyZgDY6Oj47fHFMdvzCbyjzka1dW18",
+	"g7SVctxoJRt676Vc6t/MgHuaATXbvawJUDW9Or9ZAC+mKmg3UAb5zJamI+VavbD3WRR3GzU/yfaTYlW6",
+	"b+CXuPPzgzmlJyuk2zFXBr5KSkCIv39OiMkFhDCMVSWLe1stfLPR2dBf5FbZ6eNWiUhy1cXyqBT6fU8H",
+	"zTfSugdpIQD/9dx92QMuVtxkT+TfSrPIGRiQhY+OLdETpdpvwz2NTRjzTffSfaY0RSLDETEk5JKfyyUG",
+	"lGrChGSVtKJs9c0Vhul6SowO1D2kKR1H1i/6SjCPaWFYoYCocCrkJOX/Wubuo2ZlX6/qiU32b2roa5EV",
+	"h3VdEELR7KH3DqvbmszvJQCOWgPWHLM17+sevH+2lj39XsdU2mLTjUx69ntgUv2NSV/EVjx7JD5ot8cv",
+	"IZU8ekyazlfuoKLzRSftKp6AWw036jrl8Dqi4WMOCPdfPIP1WevxWx4r8m/k+jzk+lZNPGEmzacuag0N",
+	"QvcoMWztcTu8Sp3w+kX+ooTp2E3XL2E+PWSdEb/1gE8pxZe6rSa2+Mc48Y7x5tXto93bRK3xxDvumDoi",
+	"ifo3TxaVne6Fxmrr1HcBMHNxZepD1lxQiH1p3DCfQ/Ld3V7OyxIbzo5C/t0oXHnm7/uqrwEcsZkqwLir",
+	"GnHknEvX1eoKmKZpqdw3h4z8e0Iy7tNqdy/lyZi9/vEQ+RokOXyzqP8c9Y0z1mce1f0a003jEvZFZafH",
+	"ATOPlUATer6tZq7ivZu+w1yiVRpdAhoeBzUxal/WOfKdET3KcU+UFv9evglUjJmSQMKb3u/fRM6nnwWk",
+	"nC3Xmm6dY/MoHNluoZhgSEcNYf72LVfnYHfeuIS8BNFHqnltqOfuxRzMWX0JmdL1KZeSyuoWgl4T1tTT",
+	"WxAt3173IOdErL6EbBoZta+wRZtLrmbzNg09Eyk4KHpmfCLyHZQn3TLs3BXZY0aiS85VurYGvMlXGTBN",
+	"XDBDTsJraUFa9wvDsJOXE7udQuMdAoMZxI8mNbryq5cY1b32aDlvbqcQqVskgIXAh4PlcUjG/1w3hiCQ",
+	"rhZrE7PaVLHnA5rrnVPOjowVUGW88tllF23vUxx1dTRDUCHFehpyFtqtdLdKEazPoLFq4nvjV/zEqZ+O",
+	"SBzmNORAfQK+7szP35dWekhWqtvcyoR2uPUhNebENQw4d63xHOluCKhUduo76b31wv6RLK7V9nupsqHQ",
+	"Ta9HJ6BWG9EVY2lputbgkRG1tNYXpdW4m3Qixk473Qj/pyPVVy8RoY/uca33zkFM1lMlXY+8yJDyVP3l",
+	"2FG8BmkzK0atIbuOerbS0vgGVPQy2UGcHC092uE65/RqC9LsUuLRjzOb7HdZRuux4UjZEiBr9d5SF84n",
+	"5JalmRKk5d/wS1L1i60kcypmrnduo4UbejbtaDBg4+3rxge+f0avf92GaFgLo7VvXZPwlCbpPAFa711M",
+	"WaU9dvOxLTsJt8MAWKLde9w3la7LSfZ86jAPCeAv0DzM2qt+KlKNtOYjuwjajXkTQqi1b87jjB0e6psN",
+	"qFHiwwuX3AbXE3lb0eO4Jxu44rctueAf7qMnPt58qfT7aAR7EWIn9Z37D6MHty3LctEhkKZwPY+6KMOH",
+	"w3bq7eg6rdMBupgrIa2/DsP47ihxPI0SyX1CdNQ7hV2pYoEmnavFdcfyK2Vdy2Dfoitb/cLyazD4PIcC",
+	"0CuwxiDxxm/o6rE2Jb1lKDNj6cJeIVldKpwsHG7fNLzOvP74WFzS53Jjf8CJ17/GU/xy5xoUkP7ymQlI",
+	"0KtVnU97Jt94HGmRcqKWWMJtZyTZeEmslyDr4rsodhPiBtBZr3HmYzmRn8vHHJzFS7euNN25adyoLepE",
+	"qUkJaM2f+oeu+IOsoUmlo+ryww8XPw1Pz97/4+To+Ox8xEDesBuuXSiIvZ+DPDlib5SUkFtWCOOvylGa",
+	"vcfNf13LCAwwuRwLE1096sAVJgpn+YVERIcw51Nl3J1TIwxXjXwFQ3fca/dSXsoTW99dpOYgoXC9daya",
+	"75RwAyXzZ6mop+eVVrcmxhYnH0tGFwE5rNPbWD4/V/Nqnl1KgyBzG3/vZEiI1yHRsivh6j8SoSGfI8dG",
+	"Y7D5lNo4/b+fzpzw5FJ5Z7lAXIW0nN3LwNShwj+eXcNcaWsIfv/9tXA9XOs7lGkHR+eQ7/yIs+68c3h1",
+	"raJQOnQW/ZMp4FF+WBPrtnkPYYTehRdHjkYWCGjvj9xp+kL1rwZRBbwJfojmq6VQBtjAIjXBBEoLB3QY",
+	"uYbXIcZHKsTJs0aFtNG/WYdEgvq7/e+6JcNmedAWrm9V3nVDQkyqrY5AzUDdQGdrxfabDZwRVlHjOJ9C",
+	"ft2Ip8DtX1psMFAqhdaRm90lMkOr6gwY1+ytzo4JVk54HEmml0iS8T63eovbuu9ECiu4hdoL7ZxGibhh",
+	"P4VXb2Nn3b6PUZoEibhb2UI0MwxKCrlUExO63blgkKPc0Gmbch5QiYcfKDE61baomYBaPPropCK1y4Md",
+	"gC/Srd8tQMKldU1Yc5cUkzAuyNXMRwgUxmVohnlR7zWti5rBlMoYCfZbYYCJRtH58Uh3jEJp317TyQk1",
+	"Y92fuI1KzEgIbaq6v89aOIxQprk387lsGnGTHo4Uf0vX4yq1Y33ly00L2AAY7sSBK3VE9Yv1l06+4UHF",
+	"sNFnZ5FdOAeyjozgO2zR+Hk25tF/DvSgiu5cB8XPgOeqO8qdEdY420H7u97EGLE99TFHr5VHNYMjehkb",
+	"hVno+jeEjJBhLPCi3aXH6YiaQn1MEkOU2VJbmtYtjomI5M6aHJ+DGEIm/JKKhqwp9YvVatBltowIBJcD",
+	"FPmEQ8YAdsxkbORQOAwE71BIGBz1sB/eNCL8oebDOr1FUqKuam5s4pCweJvmCHcJQEdVbL5JY6+aDRal",
+	"ZoNl7Kn15vzsRwTIOstyTQVuSIJqHAxbTf6D17IGbNdyPY85DqcpXRKsswbr3ijCdp2IcbihV+fboYZc",
+	"Gakdqk8tvk1XAVJAMerAE9HdVmbU6nnXwie7N7Wzsq1T4RPHaIknLd9Nc/XEumJ+ZKseLRSEXih4wedE",
+	"TG1aoWRDvd/LxouPSjVDO3Hb18iLpcPSEOFKu4DoFEKm1s7Nwd7eLVwZYWE3VzMnKgol4f8gn/zv3d3d",
+	"FHJerjA4OucTpdem2KrqoQ6pXv2Q5SlMMEm/POPM3dnYMo+7srrS1hnlsO7EufRdecrxRd3b571/0RX9",
+	"WbLpdCmMja+u8H04hKF21B0iKVzpvnVPphfsKhDv7Ms2FljqotrVYSCLO5WwsdDGbgxHCjlvFU08WVZ4",
+	"GTVJ+A+ztKSICenBALvVd4dhlnnuvm7ldXv2RgO3EM9U9//vE+R49bi5/jUZbsr0991ptotJExGQ6Mer",
+	"WlDSCxKt7sKF+zXIS9bshOGp7qQ14RXQ1aJW+aKzrVrl+SwGPIK26CpBVqvC3ZVNgSzWh/5aNHdSHMui",
+	"oyZlzu20EXz+5tYNBmsPGfj4FH4si07yTsh9f6O380VhHEqM2UJV7JZLssJCGXegROC6XMTncyr09guJ",
+	"zei7py6U6c08IIt7s04YIzivXEugx+Mdv4g2+zyUc45l4TLPEdhevNMjifJxMye7u3rH2SLdEUCC5kSO",
+	"1bcKkfiksSY5qU6MYKbC1ULxCEmGz9bZIvR39Vc0ElINORlaZbXRMfvklPn+nugLtRZmc98MtYCSL6Bg",
+	"M6XB94t2VY44eKXdbb/o5yN/PIqzMcXEbqei9GH5aA/PwOrFziEOk2Jv1zffKnbLhY3aTzMJnyzjDq51",
+	"BvSd2+Dl5Ex/QF7tj9ecdmoXWneuQ9v7jK49F34fte+G9zmSzOVYOodTdKRsunJfhBo+YUJqBGcX7y9O",
+	"6xKc8E1zZQfhVoMPr7rSovoGj0S1hJslhjV+L3jwQl0fgtxcykpGlNvyv4TfaEoE/iT+nyjFTrWyFglu",
+	"DqFjLjdLRMh1Z64GbRVuwJOX+NXY2JxA2byarZTfvRtzL9C+iPK7LyV5/GXau+HevFjuenfx37sfD0Pa",
+	"yIsmqYf74pZCde1gQqdkVJX9alPA3jrw/4CJX27l26d8Jao1/VEgbjLRW364FhlLxFBj8ikytJ4BtGUj",
+	"Aw0f0p616uyT8eWjquv6lOA1KAXdsL7USj425XBmXlK3MGHpzjte3+0x0TwHVMlCFVkTDkeAW+0qdi/l",
+	"WzWZYGRISMYnXMjIAqNqzBxKB0Zo4YrGYuicHxXAkOFMcWifV+8eCuuPwsY9pBb4dYA4NBXA8ekW4Ff7",
+	"4dauFGu7rTv1CHws7up3ngoi2mNhKw57/QDoAtqHxhPFhluHm6QFV8fqLjvIWCVLMKZ1W7Zh9Xb3vJB4",
+	"yVBKw/axE2mDHr7f8C6rx3z25P13cSObXGmKt9VU8vwGx2GUh0IypwYm8zki8W3accAqNO/Omn0nLixV",
+	"w/Bf6kUgXk637w8L8hSXQs7yrvBULCSeyPbu6iaGvzMv5ZmQjq+Euheit402VNHcKXTNuc2nqwg7xZ8f",
+	"LlfXcp0b3V0d+dzHpM6tmhd1w7cabS93oLnhZeXacLBxuEf3KSnGLT/kXBC9oq0w1zAGDTIHs0pDkQmD",
+	"npM93+x9XUkr7bJp+zlWXBrpjh6eal6PuT8bPRF1vhtzzP3dKtSVcFZjc4kiPsS99MH4ni745wLYN+NA",
+	"leWT37bUEQ7XmDG3mVKtsvNuMv1vX4VifECNaDVco+tu6OVFUSdrrlyqu3sp/WrI0eaXQz42Z8XXNwTj",
+	"O80VD96EJha4lOf1ndZki2uYlzxHmGQ8gL/Vzyv7eqyu03DDRHhV8VOqxKWrkFN+akQrRK/cj0r/6wWo",
+	"NPh47kWpLlaLhLCMgV50269Yub3RD69UfmyJuf8gH4hTFkNyPqcuhneHFu+ctioYudGlbm2u9Tm97t5t",
+	"5NP4ftqOe8NDFkyfLBYkm5pWvumCTQD/opZZIxxjvlKW9/yXYnoys0D2010rFyIsR9BkYUiD0HSEENcO",
+	"y0fAUJuBuxmSolNSWZFDeJpXWiNESobAkW8JFzQZd7FQp8kavUUp4S0Qg4zCOjKaNLhuay3mDE24Eaoy",
+	"fsKXciVF4rK5mvMl4/bpDh6h6SP+8OaR+j4ul2FFZLO5ucfzeGBacfmsZsPo0rCWL5TSd2ffvDZfmuF0",
+	"HEqXKhNuK3P1qHXj/OdwJDUNDL1ki6+f6yF6N7cvPCQR6Qt+3Rx1In10JfOyPK5rf303QxPTtLtlFsup",
+	"CWNRmlUTj+kj2J6pE+HFapOO1lo3+pIfubOGI72Q6dAhzPp32fhqmA1r064AJIVKZYLnmBHhSBpIlZvm",
+	"dsD7mTj3YK2mqLBfN7So+HhdnwOrqJyGleI61SKgbq8w8sZOXcq4EhhxNkdXTehSETKyMrcYW2v1G71Y",
+	"KSnB6hHKYmxXN9NG+Shkxoxqhb5DdScJEQIrav7m+rrVGHKlfUI29ap+hg2y4qTZjseSFDOfilM42h0c",
+	"DFxp0zLh/qRumU0XibpkIqwpXLOTg6y+kDJMEGohaS5fB5m4ejIbhPESnbf4LEFey200Uj2I6nKqZJRO",
+	"w0MXu16O1iuKzEfsSOs3ePGMiUttcmjxwbDSPTrZrX7ysce5u0/Tgp65An/4HgVfhecgXRS3nWcOJYS/",
+	"HalWCZsr59bqtfpmza40j7ipNcn4a4B5ra9v+aLxYmWt40PTuFLp+v26scH6tIlG0J88cyXHpuBJkFCY",
+	"quB07qrZ9oVTYr2El77kMO7QUHtEWhS1ZaSQtuTh3OHpdm1lqSfU0/DqA7VQr5rH06ar8JKjt7M4MSwl",
+	"Y6oswLQKD5+1pHDeIGo13p+0q0NiaMjYnAhjdasLQNu9F2YI/YtHa4O1rY37cpuIWwvG1okZTtgljUB3",
+	"r8vVotVvTUOuJlL820fPmw7Q6y9z2a4/+Vm0M/cz2F49duPldR2XHSGBftF4x4aO4hG+v4ZDfUBt8IDG",
+	"KN7OB+a+S3YrXyOnn6pjuSvXfWDT8pbganqXL69htOHoG+TVF9W9vLW2sAlPrFuaGHV79vHWVLNq+a6x",
+	"SAP+vzR7tNmKmbr5Gq3RsIC+Nx6uyAxc9zZ7H6JbGxLB4rBjFBPRWM6rJKAD0522Q20ZOfNaNWXLkcN2",
+	"gIXaHNE1a0tBRdcP2ffiegQPewhkPZ6p49AyXBMrzFZaxm+wOZaHTHRfj8wO/O1pIo1h9E1e+Q2oqzsP",
+	"fR7MwBg+iSGvW6T6hutxt/V6Hb8Cv4527kaoknsth3EINTRTpS3Zz48Uz/yVaHuV5qVqdfd3iCE7RT5q",
+	"A/lnTt129FNbxh7Pa4RHGHmHrnsznb0gD6tCUAkL8v1yDDj4Zp2EyBr0hQpeqoNxnoXGS5NdStd8cKmD",
+	"TNaE8JpCaRqCjgB8gm/aKSxYjocELHTr6HjnZcW5X+OxW+K2HZNan18s5v3bJ33RvZZesM9RC6Mv2+jI",
+	"U3RXg6OXTB+jHX4Or4Zvc0kfsKkwVulFfCV3WoKEA9H6xMhw/PkCs3yyQUoTB4Dv38Dj1TPVmLe9R/e4",
+	"cSWpz10wu06/w864psHDUeWG8albvfV0wGqnno4dLswXdeKf1FnSPNrtRfVZHLX8Em1HlaJ92vC1uoE9",
+	"tZe0sylct2QLa2j1aCt9ofxLeUx9359EF7ZIsoSnvhZ+x6Uerr/DNFh40VmC/CKyDiW3CoQzXzkfWi4/",
+	"f6F8IB9XzfzeLfEPWzbv1v+E96Usw3ejriF161c1u3INYf0rLVJd7n7Tw854H59wTTNq2qNxn7tR+jsV",
+	"cOp2FTq2pIG5XU5H3sCWfaKrLUADC4ZZcy7xAIZdxDABx3XQ6YqeNh3wviwnlYdr05Z+uU6qlf5u96In",
+	"nmjfFhENtlvcuRFwu1aXYpvGf9BLz6FMw2zbaFNcB6N1MKUL8Jf1EM09vxY1HL2iDUgR6vHHTc1M29h+",
+	"/AKlMP4LtS9ttjcRoQ8429S29LlPe/fuivvsgbHDhvCW7udAblg+OWwXBuE34G3zeo4EbbflSs+QR030",
+	"X5oeaUjSLeAr1CTNEu6pS9wWMb4s2ZKCbaMSedEd3n8eOTaFCEt/CBpBPdiPQOZVSvFVL0cgX4iK3V8n",
+	"fCrfkOP3oA9fnrB/T1r5zBWR0ohuMGxhKEpkHZeisJkpEyp7zz3rvmwTQ4M3wAqqz/InSFqfuyvRqPLG",
+	"pcvhAypArz09xBS7qZBQJAMuaP7nEgTfokX3jRbhPr1skIgotU+M6Hcpy+4TTnIYm3GbTym3fwsRsdFR",
+	"8Hu/8+YcuM6nzIKeMau8pPUY9T4HpjSLPsK7BZUuqC8sXatGd8z+r5HbAMbJHz0Wn3wk/pbeFdKIAlih",
+	"KuyF8FulUMzWH3A2n2pugC68xYIp13U68n1oKOGGyxxCg8ORUdoOrxZ0050Bn8GTvFaNVrjdTWU/LqHB",
+	"WG4rs+betsq0JtgkYc7dJ90zUyeTRhDQ/UNrgYBPtNfDpwCmRsNcC6Xd3fIpGKLH/Wc/DR/12wZtSUez",
+	"P9F9HUbcwJ+7t0XbYeHu1GvgafW/TGVybwICZNEXBJDFowAAJeUNIcmzq0XGfKmly7ytWcPfRezo3RU5",
+	"FWzkXU9DbkfNZSVdKHM81QI31Fw24wwyj1vfPBRX6f+MKKAGa/CxxxrPcWnE7+tACy800DVFpzheVCLK",
+	"6T/6MTH/NwPjBQyMB/qhrdfG2zqfn/YGLZzhBV3PnXbV78XjvFU+Iq058umuM/z2rkKP1I5mH9hzOxCU",
+	"s0fgE+SVu+UXjUwhJyUwq7k0nO513WUnWCwX0plmlMuMmYcyygyosxQ11D2XQmm/z31emtZd9N+U+7ua",
+	"csyN/qEqr70vZRXWOafrnoS0ijITtL+T1OsydnLUlS1AXPMDoedpWIfG3ppz9p9i/jVOjjYB3EK0Ydvf",
+	"ofXy0ZZei34vydynG4GixTvCzJhUliwL7CXCw+VJ27NoFogWCd8FItisKq2Yl+E0x23o1dfNwVZzM+30",
+	"6Rz5AIcbz1i+CAxE37FKWlG61F7v43EpLkojj8y4dBlM80pPoLiUrue+4z/cDNSZrvN+Rz4wcdEFgfi7",
+	"OkL+oW2nokVUvW4qLRf1V1/2naWtta1lvP5B0C8zAOoKvayKpMHXGQa9p+PsnSv4IjMgxsLWAa7fQXDL",
+	"NUe/nzGS2JHuyNbvmJ4+BG3uQj5UvbTJ/KaojFe667P6PaGd+XdfONqewFptOnzlm072EcmBLUN2tHzG",
+	"WxqkuYCyS7S4SfRN2EfqFTWYWjs/2NsrVc7LqTL24Pv9/X2yOvz3nxN9oHyzZ1dEHazWhhwo2X7V6UQ3",
+	"eKTedzfNZmndwSWfAHX3TX3qFpdw4rVu4k196a6hXf3yUPJyYUVu0ksLT1NfFjMh4/Ly8HlGJKtFbp0a",
+	"5PhiPCj+P7j7ePf/BwCkcVYtXh0BAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -5,7 +5,10 @@ import (
 	"errors"
 	"os"
 	"strings"
+	"study-planner-api/internal/auth"
+	"study-planner-api/internal/auth/role"
 	"study-planner-api/internal/auth/token"
+	"study-planner-api/internal/ratelimit"
 
 	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/labstack/echo/v4"
@@ -14,8 +17,12 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-func NewEchoHandler() *echo.Echo {
+// Creates the echo instance with the middlewares of the API.
+// The rate limits are kept in "rateLimitStore", which must be shared by every instance of the API.
+// "ipExtractor" gets the IP address of the client, which must not be taken from headers the client can forge.
+func NewEchoHandler(rateLimitStore ratelimit.Store, ipExtractor echo.IPExtractor) *echo.Echo {
 	e := echo.New()
+	e.IPExtractor = ipExtractor
 
 	e.Use(echoMiddleware.RequestLoggerWithConfig(echoMiddleware.RequestLoggerConfig{
 		LogURI:    true,
@@ -76,6 +83,10 @@ func NewEchoHandler() *echo.Echo {
 
 			log.Debug().Interface("cookie", cookie).Msg("cookie")
 			extendRequestContext(req, extendedCtx.host, req.Host)
+			extendRequestContext(req, extendedCtx.client, auth.Client{
+				UserAgent: req.UserAgent(),
+				IPAddress: c.RealIP(),
+			})
 
 			return next(c)
 		}
@@ -120,12 +131,26 @@ func NewEchoHandler() *echo.Echo {
 					return errors.New("invalid token")
 				}
 
+				err = auth.VerifyAccessToken(info)
+				if err != nil {
+					if !errors.Is(err, auth.ErrTokenRevoked) {
+						log.Error().Err(err).Msg("failed to verify access token")
+					}
+					return errors.New("revoked token")
+				}
+
 				if !info.IsActivated {
 					if ai.RequestValidationInput.Request.URL.Path != "/activation/email" {
 						return errors.New("user is not activated")
 					}
 				}
 
+				// The scopes of the security requirement are the roles allowed to the operation
+				userRole := role.Parse(info.Role)
+				if !userRole.Allows(ai.Scopes) {
+					return errors.New("insufficient role")
+				}
+
 				log.Debug().Interface("auth_info", info).Msg("validation")
 				// log.Debug().Interface("cookie", req.Cookies()).Msg("cookie")
 				// echoCtx := oapiEchoMiddleware.GetEchoContext(ctx)
@@ -137,12 +162,22 @@ func NewEchoHandler() *echo.Echo {
 				// }()
 				// <-ch
 
-				extendRequestContext(req, extendedCtx.authInfo, authInfo{ID: info.UserID})
+				extendRequestContext(req, extendedCtx.authInfo, authInfo{
+					ID:        info.UserID,
+					SessionID: info.SessionID,
+					Role:      userRole,
+				})
 
 				return nil
 			},
 		},
 	}))
 
+	e.Use(ratelimit.Middleware(ratelimit.Config{
+		Store:    rateLimitStore,
+		Rules:    rateLimitRules,
+		Identify: identifyPrincipal,
+	}))
+
 	return e
 }
@@ -0,0 +1,159 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	db "study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/utils"
+	"study-planner-api/internal/utils/cursor"
+
+	"gorm.io/gorm"
+)
+
+type EventType string
+
+const (
+	EventRegistered               EventType = "registered"
+	EventAccountActivated         EventType = "account_activated"
+	EventLoginSucceeded           EventType = "login_succeeded"
+	EventLoginFailed              EventType = "login_failed"
+	EventLogout                   EventType = "logout"
+	EventRefreshTokenRotated      EventType = "refresh_token_rotated"
+	EventPasswordResetRequested   EventType = "password_reset_requested"
+	EventPasswordReset            EventType = "password_reset"
+	EventRefreshTokenReuse        EventType = "refresh_token_reuse"
+	EventMfaEnabled               EventType = "mfa_enabled"
+	EventMfaDisabled              EventType = "mfa_disabled"
+	EventRecoveryCodeUsed         EventType = "recovery_code_used"
+	EventPasskeyAdded             EventType = "passkey_added"
+	EventPasskeyRemoved           EventType = "passkey_removed"
+	EventAccountLocked            EventType = "account_locked"
+	EventIdentityLinked           EventType = "identity_linked"
+	EventIdentityUnlinked         EventType = "identity_unlinked"
+	EventEmailChanged             EventType = "email_changed"
+	EventPasswordChanged          EventType = "password_changed"
+	EventAccountDeletionScheduled EventType = "account_deletion_scheduled"
+	EventAccountDeletionCancelled EventType = "account_deletion_cancelled"
+	// Recorded without the user, whose other events are anonymised when the account is erased
+	EventAccountDeleted EventType = "account_deleted"
+	// Recorded by admins, with their ID in the details
+	EventAccountDeactivated     EventType = "account_deactivated"
+	EventAccountReactivated     EventType = "account_reactivated"
+	EventSessionsRevokedByAdmin EventType = "sessions_revoked_by_admin"
+	EventActivationEmailResent  EventType = "activation_email_resent"
+	// A passkey was used with a sign count which did not increase
+	EventPasskeyCloneDetected EventType = "passkey_clone_detected"
+)
+
+func (e EventType) String() string {
+	return string(e)
+}
+
+// Where an event comes from
+type Client struct {
+	UserAgent string
+	IPAddress string
+}
+
+type Event struct {
+	UserID *int32
+	Type   EventType
+	// Stored as JSON, can be nil
+	Details map[string]any
+	// Nil for events of the system rather than of a request
+	Client *Client
+}
+
+func Record(event Event) error {
+	return RecordTx(db.Instance().DB, event)
+}
+
+// Records the event as part of the transaction "tx"
+func RecordTx(tx *gorm.DB, event Event) error {
+	auditEvent := model.AuditEvent{
+		UserID:    event.UserID,
+		EventType: event.Type.String(),
+	}
+
+	if event.Details != nil {
+		details, err := json.Marshal(event.Details)
+		if err != nil {
+			return err
+		}
+		auditEvent.Details = utils.Ptr(string(details))
+	}
+
+	if event.Client != nil {
+		auditEvent.IPAddress = nullIfEmpty(event.Client.IPAddress)
+		auditEvent.UserAgent = nullIfEmpty(event.Client.UserAgent)
+	}
+
+	return tx.
+		Select("UserID", "EventType", "Details", "IPAddress", "UserAgent").
+		Create(&auditEvent).
+		Error
+}
+
+func nullIfEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+type Filter struct {
+	// Events of every user when nil
+	UserID *int32
+	// Events of every type when empty
+	Types     []EventType
+	IPAddress *string
+}
+
+var eventsKeyset = cursor.Keyset{
+	Name:       "id:desc",
+	Expression: "audit_event.id",
+	IDColumn:   "audit_event.id",
+	Desc:       true,
+}
+
+// Lists the events matching the filter, the most recent first
+func List(filter Filter, pagination *utils.Pagination) ([]model.AuditEvent, error) {
+	constructQuery := func(db *gorm.DB) *gorm.DB {
+		query := db.Model(&model.AuditEvent{})
+
+		if filter.UserID != nil {
+			query = query.Where("user_id = ?", *filter.UserID)
+		}
+		if len(filter.Types) > 0 {
+			query = query.Where("event_type IN ?", filter.Types)
+		}
+		if filter.IPAddress != nil {
+			query = query.Where("ip_address = ?", *filter.IPAddress)
+		}
+
+		return query
+	}
+
+	var events []model.AuditEvent
+	err := cursor.Find(
+		constructQuery,
+		"",
+		eventsKeyset,
+		pagination,
+		&events,
+		func(e model.AuditEvent) (any, int32) {
+			return e.ID, e.ID
+		},
+	)
+	if err != nil {
+		if errors.Is(err, cursor.ErrInvalidCursor) {
+			return nil, ErrInvalidCursor
+		}
+		return nil, err
+	}
+
+	return events, nil
+}
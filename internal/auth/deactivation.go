@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"errors"
+	"study-planner-api/internal/audit"
+	"study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var ErrSelfDeactivation = errors.New("admins cannot deactivate their own account")
+
+// Deactivates the account of the user on behalf of the admin "adminID", and logs it out of every session.
+// The user cannot log in until the account is reactivated, see VerifyLoginInfo and StartSession.
+// Deactivating an account which already is does nothing.
+func DeactivateAccount(userID int32, adminID int32, client Client) error {
+	if userID == adminID {
+		return ErrSelfDeactivation
+	}
+
+	err := database.Instance().Transaction(func(tx *gorm.DB) error {
+		changed, err := setAccountDisabledAt(tx, userID, utils.Ptr(time.Now()))
+		if err != nil || !changed {
+			return err
+		}
+
+		err = revokeAllTokens(tx, userID)
+		if err != nil {
+			return err
+		}
+
+		return audit.RecordTx(tx, audit.Event{
+			UserID: &userID,
+			Type:   audit.EventAccountDeactivated,
+			Details: map[string]any{
+				"admin_id": adminID,
+			},
+			Client: &client,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	forgetTokenStates(userID)
+
+	return nil
+}
+
+// Reactivates the account of the user on behalf of the admin "adminID".
+// Reactivating an account which is not deactivated does nothing.
+func ReactivateAccount(userID int32, adminID int32, client Client) error {
+	return database.Instance().Transaction(func(tx *gorm.DB) error {
+		changed, err := setAccountDisabledAt(tx, userID, nil)
+		if err != nil || !changed {
+			return err
+		}
+
+		return audit.RecordTx(tx, audit.Event{
+			UserID: &userID,
+			Type:   audit.EventAccountReactivated,
+			Details: map[string]any{
+				"admin_id": adminID,
+			},
+			Client: &client,
+		})
+	})
+}
+
+// Sets when the account was deactivated, nil to reactivate it.
+// Returns whether the account changed.
+func setAccountDisabledAt(tx *gorm.DB, userID int32, disabledAt *time.Time) (bool, error) {
+	var user model.User
+	result := tx.Where("id = ?", userID).First(&user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return false, ErrUserNotFound
+		}
+		return false, result.Error
+	}
+
+	if (user.DisabledAt != nil) == (disabledAt != nil) {
+		return false, nil
+	}
+
+	result = tx.
+		Model(&model.User{}).
+		Where("id = ?", userID).
+		Update("disabled_at", disabledAt)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return true, nil
+}
+
+// Logs the user out of every session on behalf of the admin "adminID"
+func ForceLogout(userID int32, adminID int32, client Client) error {
+	err := database.Instance().Transaction(func(tx *gorm.DB) error {
+		err := revokeAllTokens(tx, userID)
+		if err != nil {
+			return err
+		}
+
+		return audit.RecordTx(tx, audit.Event{
+			UserID: &userID,
+			Type:   audit.EventSessionsRevokedByAdmin,
+			Details: map[string]any{
+				"admin_id": adminID,
+			},
+			Client: &client,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	forgetTokenStates(userID)
+
+	return nil
+}
@@ -0,0 +1,281 @@
+package auth
+
+import (
+	"errors"
+	"html/template"
+	"os"
+	"path/filepath"
+	"strconv"
+	"study-planner-api/internal/audit"
+	"study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/utils"
+	"study-planner-api/internal/utils/email"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+func getAccountDeletionScheduledTemplate() *template.Template {
+	path := filepath.Join("templates", "account-deletion-scheduled.html")
+
+	tmpl, err := template.ParseFiles(path)
+	if err != nil {
+		panic(err)
+	}
+
+	return tmpl
+}
+
+func getAccountDeletedTemplate() *template.Template {
+	path := filepath.Join("templates", "account-deleted.html")
+
+	tmpl, err := template.ParseFiles(path)
+	if err != nil {
+		panic(err)
+	}
+
+	return tmpl
+}
+
+func getAccountDeletionGracePeriod() time.Duration {
+	days, err := strconv.Atoi(os.Getenv("ACCOUNT_DELETION_GRACE_DAYS"))
+	if err != nil || days < 0 {
+		days = 14
+	}
+
+	return time.Hour * 24 * time.Duration(days)
+}
+
+var (
+	errAccountDeletionCancelled = errors.New("account deletion cancelled")
+
+	accountDeletionScheduledTemplate = getAccountDeletionScheduledTemplate()
+	accountDeletedTemplate           = getAccountDeletedTemplate()
+	accountDeletionGracePeriod       = getAccountDeletionGracePeriod()
+)
+
+type accountDeletionScheduledEmailData struct {
+	DeletionDate string
+}
+
+// Schedules the deletion of the account after the grace period, and logs the user out of every session.
+// Logging in again before then cancels the deletion, see StartSession.
+// The user must confirm it, see reauthenticate.
+// Returns when the account will be erased.
+func ScheduleAccountDeletion(userID int32, sessionID string, password *string, client Client) (time.Time, error) {
+	var user model.User
+	result := database.Instance().
+		Where("id = ?", userID).
+		First(&user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return time.Time{}, ErrUserNotFound
+		}
+		return time.Time{}, result.Error
+	}
+
+	err := reauthenticate(user, sessionID, password, client)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	deletionTime := time.Now().Add(accountDeletionGracePeriod)
+	err = database.Instance().Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Model(&model.User{}).
+			Where("id = ?", userID).
+			Update("deletion_scheduled_at", deletionTime)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		err := revokeAllTokens(tx, userID)
+		if err != nil {
+			return err
+		}
+
+		return audit.RecordTx(tx, audit.Event{
+			UserID: &userID,
+			Type:   audit.EventAccountDeletionScheduled,
+			Details: map[string]any{
+				"deletion_scheduled_at": deletionTime,
+			},
+			Client: &client,
+		})
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	forgetTokenStates(userID)
+
+	if user.Email != nil {
+		sendAccountDeletionEmail(
+			*user.Email,
+			"Your account will be deleted",
+			accountDeletionScheduledTemplate,
+			accountDeletionScheduledEmailData{DeletionDate: deletionTime.Format("January 2, 2006")},
+		)
+	}
+
+	return deletionTime, nil
+}
+
+// Cancels the scheduled deletion of the account, if any
+func cancelAccountDeletion(tx *gorm.DB, userID int32, client Client) error {
+	result := tx.
+		Model(&model.User{}).
+		Where("id = ? AND deletion_scheduled_at IS NOT NULL", userID).
+		Update("deletion_scheduled_at", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+
+	return audit.RecordTx(tx, audit.Event{
+		UserID: &userID,
+		Type:   audit.EventAccountDeletionCancelled,
+		Client: &client,
+	})
+}
+
+// Erases the accounts whose deletion is due, returns how many were erased.
+// Every account is erased on its own, a failure does not stop the others.
+func PurgeDeletedAccounts() (int64, error) {
+	var users []model.User
+	result := database.Instance().
+		Where("deletion_scheduled_at <= ?", time.Now()).
+		Find(&users)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	var erased int64
+	var firstErr error
+	for _, user := range users {
+		err := eraseAccount(user)
+		if errors.Is(err, errAccountDeletionCancelled) {
+			continue
+		}
+		if err != nil {
+			log.Error().Err(err).Int32("user_id", user.ID).Msg("failed to erase account")
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		erased++
+	}
+
+	return erased, firstErr
+}
+
+// Erases the user and all of its data in one transaction.
+// Audit events of the user are kept without the user nor their details and client, along with one of the deletion.
+func eraseAccount(user model.User) error {
+	err := database.Instance().Transaction(func(tx *gorm.DB) error {
+		// The deletion may have been cancelled since the user was selected
+		result := tx.
+			Model(&model.User{}).
+			Where("id = ? AND deletion_scheduled_at <= ?", user.ID, time.Now()).
+			Limit(1).
+			Find(&model.User{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errAccountDeletionCancelled
+		}
+
+		// Trashed tasks are erased too
+		userTasks := tx.
+			Unscoped().
+			Model(&model.Task{}).
+			Select("id").
+			Where("user_id = ?", user.ID)
+
+		erasures := []struct {
+			query *gorm.DB
+			model any
+		}{
+			{tx.Where("task_id IN (?)", userTasks), &model.FocusSession{}},
+			{tx.Unscoped().Where("user_id = ?", user.ID), &model.Task{}},
+			{tx.Where("user_id = ?", user.ID), &model.TaskView{}},
+			{tx.Where("user_id = ?", user.ID), &model.Token{}},
+			{tx.Where("user_id = ?", user.ID), &model.UserSession{}},
+			{tx.Where("user_id = ?", user.ID), &model.ExternalIdentity{}},
+			{tx.Where("user_id = ?", user.ID), &model.WebauthnCredential{}},
+			{tx.Where("user_id = ?", user.ID), &model.UserTotp{}},
+			{tx.Where("user_id = ?", user.ID), &model.UserRecoveryCode{}},
+			{tx.Where("user_id = ?", user.ID), &model.OauthExchangeCode{}},
+			{tx.Where("user_id = ?", user.ID), &model.MfaChallenge{}},
+		}
+		for _, erasure := range erasures {
+			err := erasure.query.Delete(erasure.model).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if user.Email != nil {
+			result = tx.
+				Where("scope = ? AND key = ?", throttleScopeAccount, throttleAccountKey(*user.Email)).
+				Delete(&model.LoginThrottle{})
+			if result.Error != nil {
+				return result.Error
+			}
+		}
+
+		// Details may hold emails and other personal data, as the client does
+		result = tx.
+			Model(&model.AuditEvent{}).
+			Where("user_id = ?", user.ID).
+			Updates(map[string]any{
+				"user_id":    nil,
+				"details":    nil,
+				"ip_address": nil,
+				"user_agent": nil,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.Delete(&model.User{}, user.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		return audit.RecordTx(tx, audit.Event{
+			Type: audit.EventAccountDeleted,
+			Details: map[string]any{
+				"deletion_scheduled_at": user.DeletionScheduledAt,
+			},
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	forgetTokenStates(user.ID)
+
+	if user.Email != nil {
+		sendAccountDeletionEmail(*user.Email, "Your account has been deleted", accountDeletedTemplate, nil)
+	}
+
+	return nil
+}
+
+// Failures are only logged, since the account is deleted or scheduled to be either way
+func sendAccountDeletionEmail(userEmail string, subject string, tmpl *template.Template, data any) {
+	content, err := utils.CreateHtml(tmpl, data)
+	if err == nil {
+		err = email.Send(userEmail, subject, content)
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("failed to send account deletion email")
+	}
+}
@@ -0,0 +1,261 @@
+package auth
+
+import (
+	"errors"
+	"html/template"
+	"net/url"
+	"os"
+	"path/filepath"
+	"study-planner-api/internal/audit"
+	"study-planner-api/internal/auth/token"
+	"study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/utils"
+	"study-planner-api/internal/utils/email"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+func getEmailChangeTemplate() *template.Template {
+	path := filepath.Join("templates", "email-change.html")
+
+	tmpl, err := template.ParseFiles(path)
+	if err != nil {
+		panic(err)
+	}
+
+	return tmpl
+}
+
+func getEmailChangeNoticeTemplate() *template.Template {
+	path := filepath.Join("templates", "email-change-notice.html")
+
+	tmpl, err := template.ParseFiles(path)
+	if err != nil {
+		panic(err)
+	}
+
+	return tmpl
+}
+
+func getEmailChangeCallbackUrl() *url.URL {
+	url, err := url.Parse(os.Getenv("EMAIL_CHANGE_CALLBACK_URL"))
+	if err != nil {
+		panic(err)
+	}
+
+	return url
+}
+
+var (
+	ErrEmailInUse = errors.New("email is used by another user")
+	ErrSameEmail  = errors.New("email is already the one of the user")
+
+	emailChangeTemplate       = getEmailChangeTemplate()
+	emailChangeNoticeTemplate = getEmailChangeNoticeTemplate()
+	emailChangeCallbackUrl    = getEmailChangeCallbackUrl()
+)
+
+type emailChangeEmailData struct {
+	Url string
+}
+
+type emailChangeNoticeEmailData struct {
+	NewEmail string
+}
+
+func isEmailInUse(tx *gorm.DB, userEmail string) (bool, error) {
+	var count int64
+	result := tx.
+		Model(&model.User{}).
+		Where("email = ?", userEmail).
+		Count(&count)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return count > 0, nil
+}
+
+// Requests to change the email of the user to "newEmail".
+// A confirmation link is sent to the new email, and a notice to the current one.
+// The change is only applied once confirmed, with ConfirmEmailChange.
+// The user must first confirm the request from the session "sessionID", see reauthenticate,
+// since the new email could otherwise be used to reset the password.
+func RequestEmailChange(userID int32, sessionID string, newEmail string, password *string, client Client) error {
+	var user model.User
+	result := database.Instance().
+		Where("id = ?", userID).
+		First(&user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return ErrUserNotFound
+		}
+		return result.Error
+	}
+
+	err := reauthenticate(user, sessionID, password, client)
+	if err != nil {
+		return err
+	}
+
+	if user.Email != nil && *user.Email == newEmail {
+		return ErrSameEmail
+	}
+
+	inUse, err := isEmailInUse(database.Instance().DB, newEmail)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return ErrEmailInUse
+	}
+
+	result = database.Instance().
+		Model(&model.User{}).
+		Where("id = ?", userID).
+		Update("pending_email", newEmail)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	// A previous request is replaced, along with its token
+	changeToken, err := token.CreateToken(userID, token.EmailChange)
+	if err != nil {
+		return err
+	}
+
+	url := *emailChangeCallbackUrl
+	q := url.Query()
+	q.Set("token", changeToken)
+	url.RawQuery = q.Encode()
+
+	content, err := utils.CreateHtml(
+		emailChangeTemplate,
+		emailChangeEmailData{Url: url.String()},
+	)
+	if err != nil {
+		return err
+	}
+
+	err = email.Send(newEmail, "Confirm your new email", content)
+	if err != nil {
+		return ErrCannotSendEmail
+	}
+
+	if user.Email != nil {
+		sendEmailChangeNotice(*user.Email, newEmail)
+	}
+
+	return nil
+}
+
+// Warns the current email of the user about the change, failures are only logged
+func sendEmailChangeNotice(currentEmail string, newEmail string) {
+	content, err := utils.CreateHtml(
+		emailChangeNoticeTemplate,
+		emailChangeNoticeEmailData{NewEmail: newEmail},
+	)
+	if err == nil {
+		err = email.Send(currentEmail, "A change of your email was requested", content)
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("failed to send email change notice")
+	}
+}
+
+// Applies the email change requested by the user, with the token sent to the new email.
+// The new email is verified by the token, so the account is activated.
+// Every session of the user but "sessionID" is revoked, since they were logged in with the old email.
+func ConfirmEmailChange(userID int32, sessionID string, changeToken string, client Client) error {
+	err := database.Instance().Transaction(func(tx *gorm.DB) error {
+		var t model.Token
+		result := tx.
+			Model(&model.Token{}).
+			Where("user_id = ? AND purpose = ?", userID, token.EmailChange.String()).
+			First(&t)
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return ErrInvalidToken
+			}
+			return result.Error
+		}
+
+		if !token.VerifyHash(changeToken, t.TokenHash) {
+			return ErrInvalidToken
+		}
+
+		if t.ExpiresAt.Before(time.Now()) {
+			return ErrExpiredToken
+		}
+
+		// The token is deleted first, so that concurrent confirmations cannot both succeed
+		result = tx.
+			Where("id = ? AND token_hash = ?", t.ID, t.TokenHash).
+			Delete(&model.Token{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrInvalidToken
+		}
+
+		var user model.User
+		result = tx.Where("id = ?", userID).First(&user)
+		if result.Error != nil {
+			return result.Error
+		}
+		if user.PendingEmail == nil {
+			return ErrInvalidToken
+		}
+		newEmail := *user.PendingEmail
+
+		// The email may have been taken since the change was requested
+		inUse, err := isEmailInUse(tx, newEmail)
+		if err != nil {
+			return err
+		}
+		if inUse {
+			return ErrEmailInUse
+		}
+
+		result = tx.
+			Model(&model.User{}).
+			Where("id = ?", userID).
+			Updates(map[string]any{
+				"email":         newEmail,
+				"pending_email": nil,
+				"is_activated":  true,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.
+			Where("user_id = ? AND family_id <> ?", userID, sessionID).
+			Delete(&model.UserSession{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		return audit.RecordTx(tx, audit.Event{
+			UserID: &userID,
+			Type:   audit.EventEmailChanged,
+			Details: map[string]any{
+				"old_email":        user.Email,
+				"new_email":        newEmail,
+				"revoked_sessions": result.RowsAffected,
+			},
+			Client: &client,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	forgetTokenStates(userID)
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"errors"
+	"study-planner-api/internal/auth/token"
+	"study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"time"
+
+	"golang.org/x/oauth2"
+	"gorm.io/gorm"
+)
+
+const (
+	exchangeCodeLength   = 32
+	exchangeCodeDuration = time.Minute
+)
+
+var ErrInvalidExchangeCode = errors.New("invalid exchange code")
+
+// Creates a one-time code, redeemed by the application for a login of the user.
+// "codeChallenge" is the S256 PKCE challenge of the application, empty when it gave none.
+func CreateExchangeCode(userID int32, codeChallenge string) (string, error) {
+	code, err := token.GenerateRandomToken(exchangeCodeLength)
+	if err != nil {
+		return "", err
+	}
+
+	result := database.Instance().Create(&model.OauthExchangeCode{
+		CodeHash:      token.HashToken(code),
+		UserID:        userID,
+		CodeChallenge: codeChallenge,
+		ExpiresAt:     time.Now().Add(exchangeCodeDuration),
+	})
+	if result.Error != nil {
+		return "", result.Error
+	}
+
+	return code, nil
+}
+
+// Redeems a code created by CreateExchangeCode, with the PKCE verifier of its challenge if it has one.
+// Returns the user, whose login must then be completed.
+func RedeemExchangeCode(code string, codeVerifier string) (model.User, error) {
+	var user model.User
+
+	err := database.Instance().Transaction(func(tx *gorm.DB) error {
+		var exchangeCode model.OauthExchangeCode
+		result := tx.
+			Where("code_hash = ?", token.HashToken(code)).
+			Limit(1).
+			Find(&exchangeCode)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 || exchangeCode.ExpiresAt.Before(time.Now()) {
+			return ErrInvalidExchangeCode
+		}
+
+		if exchangeCode.CodeChallenge != "" {
+			challenge := oauth2.S256ChallengeFromVerifier(codeVerifier)
+			if subtle.ConstantTimeCompare([]byte(challenge), []byte(exchangeCode.CodeChallenge)) != 1 {
+				return ErrInvalidExchangeCode
+			}
+		}
+
+		// The code is deleted first, so that concurrent redemptions cannot both succeed
+		result = tx.
+			Where("code_hash = ?", exchangeCode.CodeHash).
+			Delete(&model.OauthExchangeCode{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrInvalidExchangeCode
+		}
+
+		return tx.Where("id = ?", exchangeCode.UserID).First(&user).Error
+	})
+	if err != nil {
+		return model.User{}, err
+	}
+
+	return user, nil
+}
+
+// Deletes the exchange codes which expired without being redeemed.
+// Returns the number of deleted codes.
+func PurgeExpiredExchangeCodes() (int64, error) {
+	result := database.Instance().
+		Where("expires_at < ?", time.Now()).
+		Delete(&model.OauthExchangeCode{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
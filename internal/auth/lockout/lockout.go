@@ -0,0 +1,74 @@
+// Exponential backoff and temporary lockout after failed attempts.
+package lockout
+
+import "time"
+
+type Policy struct {
+	// Failures allowed in a row before attempts are delayed
+	FreeAttempts int32
+	// Delay after the first failure past the free ones, doubled by every further failure
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// Failures in a row after which every failure locks out for LockoutDuration
+	LockoutThreshold int32
+	LockoutDuration  time.Duration
+	// Failures are forgotten after this long without a new one
+	ResetAfter time.Duration
+}
+
+// Failed attempts of a key, such as an account or an IP address
+type State struct {
+	Failures     int32
+	LastFailedAt *time.Time
+	// The next attempt is rejected before this time
+	BlockedUntil *time.Time
+}
+
+// Records a failed attempt at "now".
+// Returns the new state, blocked for the delay of its failure count.
+func (p Policy) Fail(s State, now time.Time) State {
+	if s.LastFailedAt != nil && now.Sub(*s.LastFailedAt) >= p.ResetAfter {
+		s.Failures = 0
+	}
+
+	s.Failures++
+	s.LastFailedAt = &now
+	s.BlockedUntil = nil
+
+	if delay := p.delay(s.Failures); delay > 0 {
+		blockedUntil := now.Add(delay)
+		s.BlockedUntil = &blockedUntil
+	}
+
+	return s
+}
+
+func (p Policy) delay(failures int32) time.Duration {
+	switch {
+	case failures >= p.LockoutThreshold:
+		return p.LockoutDuration
+	case failures > p.FreeAttempts:
+		delay := p.BaseDelay
+		for i := failures - p.FreeAttempts - 1; i > 0 && delay < p.MaxDelay; i-- {
+			delay *= 2
+		}
+		return min(delay, p.MaxDelay)
+	default:
+		return 0
+	}
+}
+
+// Gets how long to wait before the next attempt, 0 if it is allowed at "now"
+func (p Policy) RetryAfter(s State, now time.Time) time.Duration {
+	if s.BlockedUntil == nil || !now.Before(*s.BlockedUntil) {
+		return 0
+	}
+
+	return s.BlockedUntil.Sub(now)
+}
+
+// Checks whether the last failure of the state started a lockout,
+// as opposed to failures after it which extend it
+func (p Policy) JustLocked(s State) bool {
+	return s.Failures == p.LockoutThreshold
+}
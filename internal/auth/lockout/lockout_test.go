@@ -0,0 +1,64 @@
+package lockout
+
+import (
+	"testing"
+	"time"
+)
+
+var testPolicy = Policy{
+	FreeAttempts:     2,
+	BaseDelay:        time.Second,
+	MaxDelay:         time.Second * 5,
+	LockoutThreshold: 6,
+	LockoutDuration:  time.Minute * 15,
+	ResetAfter:       time.Hour,
+}
+
+func TestFailBackoff(t *testing.T) {
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	want := []time.Duration{0, 0, time.Second, time.Second * 2, time.Second * 4, time.Minute * 15, time.Minute * 15}
+
+	var s State
+	for i, delay := range want {
+		s = testPolicy.Fail(s, now)
+		if got := testPolicy.RetryAfter(s, now); got != delay {
+			t.Errorf("RetryAfter() after %d failures = %v, want %v", i+1, got, delay)
+		}
+		if locked := testPolicy.JustLocked(s); locked != (s.Failures == 6) {
+			t.Errorf("JustLocked() after %d failures = %t", i+1, locked)
+		}
+	}
+
+	if got := testPolicy.RetryAfter(s, now.Add(time.Minute*15)); got != 0 {
+		t.Errorf("RetryAfter() after the lockout = %v, want 0", got)
+	}
+}
+
+func TestFailMaxDelay(t *testing.T) {
+	policy := testPolicy
+	policy.LockoutThreshold = 100
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	var s State
+	for range 50 {
+		s = policy.Fail(s, now)
+	}
+
+	if got := policy.RetryAfter(s, now); got != policy.MaxDelay {
+		t.Errorf("RetryAfter() = %v, want the max delay", got)
+	}
+}
+
+func TestFailReset(t *testing.T) {
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	var s State
+	for range 4 {
+		s = testPolicy.Fail(s, now)
+	}
+
+	s = testPolicy.Fail(s, now.Add(time.Hour))
+	if s.Failures != 1 || testPolicy.RetryAfter(s, now.Add(time.Hour)) != 0 {
+		t.Errorf("Fail() after the reset window = %+v, want a single failure", s)
+	}
+}
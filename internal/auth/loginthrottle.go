@@ -0,0 +1,312 @@
+package auth
+
+import (
+	"fmt"
+	"html/template"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"study-planner-api/internal/audit"
+	"study-planner-api/internal/auth/lockout"
+	db "study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/utils"
+	"study-planner-api/internal/utils/email"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type throttleScope string
+
+const (
+	throttleScopeAccount throttleScope = "account"
+	throttleScopeIP      throttleScope = "ip"
+	// Second factors of a user, keyed by its ID
+	throttleScopeMfa throttleScope = "mfa"
+)
+
+var (
+	accountLockoutPolicy = lockout.Policy{
+		FreeAttempts:     3,
+		BaseDelay:        time.Second,
+		MaxDelay:         time.Minute * 5,
+		LockoutThreshold: 10,
+		LockoutDuration:  time.Minute * 15,
+		ResetAfter:       time.Hour * 24,
+	}
+	// Many users can share an address, so it tolerates more failures
+	ipLockoutPolicy = lockout.Policy{
+		FreeAttempts:     20,
+		BaseDelay:        time.Second,
+		MaxDelay:         time.Minute * 5,
+		LockoutThreshold: 100,
+		LockoutDuration:  time.Hour,
+		ResetAfter:       time.Hour * 24,
+	}
+
+	accountLockedTemplate = getAccountLockedTemplate()
+)
+
+// Time taken by a failed login which locks the account out, at least as long as sending the email
+const accountLockedResponseTime = time.Second * 2
+
+func getAccountLockedTemplate() *template.Template {
+	path := filepath.Join("templates", "account-locked.html")
+
+	tmpl, err := template.ParseFiles(path)
+	if err != nil {
+		panic(err)
+	}
+
+	return tmpl
+}
+
+type accountLockedEmailData struct {
+	Failures int32
+	Until    string
+}
+
+// Login attempts are rejected until RetryAfter has passed
+type TooManyAttemptsError struct {
+	RetryAfter time.Duration
+}
+
+func (e *TooManyAttemptsError) Error() string {
+	return fmt.Sprintf("too many login attempts, retry after %s", e.RetryAfter)
+}
+
+func throttleAccountKey(userEmail string) string {
+	return strings.ToLower(strings.TrimSpace(userEmail))
+}
+
+// A login attempt, counted as a failure of the account and the IP address before the credentials are verified
+type loginAttempt struct {
+	// Scope of the account, either its email or, for a second factor, its user
+	accountScope throttleScope
+	accountKey   string
+	ipAddress    string
+	// States of the account and the IP address with the failure of the attempt
+	account lockout.State
+	ip      lockout.State
+	// State of the IP address before the attempt, restored when the login succeeds
+	ipBefore lockout.State
+}
+
+// Reserves a login attempt, unless the account or the IP address is blocked.
+// The attempt is counted as a failure up front, so that concurrent attempts cannot all pass the check
+// before any failure is recorded, and is taken back by releaseLoginAttempt when the login succeeds.
+// Unknown emails are tracked the same way, so that they cannot be told apart.
+func reserveLoginAttempt(userEmail string, ipAddress string) (loginAttempt, error) {
+	return reserveAttempt(loginAttempt{
+		accountScope: throttleScopeAccount,
+		accountKey:   throttleAccountKey(userEmail),
+		ipAddress:    ipAddress,
+	})
+}
+
+// Reserves an attempt to complete the login of the user with a second factor,
+// throttled as reserveLoginAttempt throttles passwords.
+func reserveMfaAttempt(userID int32, ipAddress string) (loginAttempt, error) {
+	return reserveAttempt(loginAttempt{
+		accountScope: throttleScopeMfa,
+		accountKey:   strconv.Itoa(int(userID)),
+		ipAddress:    ipAddress,
+	})
+}
+
+func reserveAttempt(attempt loginAttempt) (loginAttempt, error) {
+	now := time.Now()
+
+	err := db.Instance().Transaction(func(tx *gorm.DB) error {
+		var err error
+		_, attempt.account, err = reserveThrottle(tx, attempt.accountScope, attempt.accountKey, accountLockoutPolicy, now)
+		if err != nil {
+			return err
+		}
+
+		attempt.ipBefore, attempt.ip, err = reserveThrottle(tx, throttleScopeIP, attempt.ipAddress, ipLockoutPolicy, now)
+		return err
+	})
+	if err != nil {
+		return loginAttempt{}, err
+	}
+
+	return attempt, nil
+}
+
+// Records a failure of the key if it is not blocked, returns its states before and after the failure
+func reserveThrottle(
+	tx *gorm.DB,
+	scope throttleScope,
+	key string,
+	policy lockout.Policy,
+	now time.Time,
+) (lockout.State, lockout.State, error) {
+	var throttle model.LoginThrottle
+	result := tx.
+		Model(&model.LoginThrottle{}).
+		Where("scope = ? AND key = ?", scope, key).
+		Limit(1).
+		Find(&throttle)
+	if result.Error != nil {
+		return lockout.State{}, lockout.State{}, result.Error
+	}
+	exists := result.RowsAffected > 0
+
+	before := lockout.State{
+		Failures:     throttle.Failures,
+		LastFailedAt: throttle.LastFailedAt,
+		BlockedUntil: throttle.BlockedUntil,
+	}
+	if retryAfter := policy.RetryAfter(before, now); retryAfter > 0 {
+		return lockout.State{}, lockout.State{}, &TooManyAttemptsError{RetryAfter: retryAfter}
+	}
+
+	after := policy.Fail(before, now)
+
+	// The row is only written if no other attempt was reserved since it was read
+	if exists {
+		result = tx.
+			Model(&model.LoginThrottle{}).
+			Where("id = ? AND failures = ?", throttle.ID, throttle.Failures).
+			Updates(map[string]any{
+				"failures":       after.Failures,
+				"last_failed_at": after.LastFailedAt,
+				"blocked_until":  after.BlockedUntil,
+			})
+	} else {
+		result = tx.
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&model.LoginThrottle{
+				Scope:        string(scope),
+				Key:          key,
+				Failures:     after.Failures,
+				LastFailedAt: after.LastFailedAt,
+				BlockedUntil: after.BlockedUntil,
+			})
+	}
+	if result.Error != nil {
+		return lockout.State{}, lockout.State{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		// A concurrent attempt must complete first
+		return lockout.State{}, lockout.State{}, &TooManyAttemptsError{RetryAfter: policy.BaseDelay}
+	}
+
+	return before, after, nil
+}
+
+// Takes back the failure of a successful login.
+// The failures of the account are forgotten, while the ones of the IP address are kept,
+// so that logging in to an owned account does not reset them.
+// The IP address is left as is when other attempts were reserved since.
+func releaseLoginAttempt(attempt loginAttempt) error {
+	return db.Instance().Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Where("scope = ? AND key = ?", attempt.accountScope, attempt.accountKey).
+			Delete(&model.LoginThrottle{}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		ipThrottle := tx.
+			Model(&model.LoginThrottle{}).
+			Where("scope = ? AND key = ? AND failures = ?", throttleScopeIP, attempt.ipAddress, attempt.ip.Failures)
+
+		if attempt.ipBefore.Failures == 0 {
+			return ipThrottle.Delete(&model.LoginThrottle{}).Error
+		}
+
+		return ipThrottle.
+			Updates(map[string]any{
+				"failures":       attempt.ipBefore.Failures,
+				"last_failed_at": attempt.ipBefore.LastFailedAt,
+				"blocked_until":  attempt.ipBefore.BlockedUntil,
+			}).
+			Error
+	})
+}
+
+// Records the audit event of a failed login, whose failure was counted when the attempt was reserved.
+// Notifies the user when its account gets locked out.
+func recordLoginFailure(user *model.User, attempt loginAttempt, reason loginFailureReason, client Client) error {
+	var userID *int32
+	if user != nil {
+		userID = &user.ID
+	}
+
+	err := recordLoginFailed(userID, reason, client)
+	if err != nil {
+		return err
+	}
+
+	if !accountLockoutPolicy.JustLocked(attempt.account) {
+		return nil
+	}
+
+	// Unknown emails are locked out too, the response takes as long whether an email is sent or not
+	started := time.Now()
+	defer func() {
+		time.Sleep(accountLockedResponseTime - time.Since(started))
+	}()
+
+	if user == nil {
+		return nil
+	}
+
+	log.Warn().Int32("user_id", user.ID).Msg("account locked out after failed logins")
+
+	err = audit.Record(audit.Event{
+		UserID:  &user.ID,
+		Type:    audit.EventAccountLocked,
+		Details: map[string]any{"failures": attempt.account.Failures},
+		Client:  &client,
+	})
+	if err != nil {
+		return err
+	}
+
+	if user.Email != nil {
+		sendAccountLockedEmail(*user.Email, attempt.account)
+	}
+
+	return nil
+}
+
+// Deletes the failures of the accounts and IP addresses which have been forgotten by their policy,
+// unknown emails would otherwise fill the table.
+// Returns the number of deleted rows.
+func PurgeStaleLoginThrottles() (int64, error) {
+	retention := max(accountLockoutPolicy.ResetAfter, ipLockoutPolicy.ResetAfter)
+
+	result := db.Instance().
+		Where("last_failed_at IS NULL OR last_failed_at < ?", time.Now().Add(-retention)).
+		Delete(&model.LoginThrottle{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+func sendAccountLockedEmail(userEmail string, state lockout.State) {
+	until := ""
+	if state.BlockedUntil != nil {
+		until = state.BlockedUntil.UTC().Format("2006-01-02 15:04 MST")
+	}
+
+	content, err := utils.CreateHtml(
+		accountLockedTemplate,
+		accountLockedEmailData{Failures: state.Failures, Until: until},
+	)
+	if err == nil {
+		err = email.Send(userEmail, "Your account has been locked", content)
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("failed to send account locked email")
+	}
+}
@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"errors"
+	"html/template"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"study-planner-api/internal/auth/token"
+	"study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/utils"
+	"study-planner-api/internal/utils/email"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func getMagicLoginTemplate() *template.Template {
+	path := filepath.Join("templates", "magic-login.html")
+
+	tmpl, err := template.ParseFiles(path)
+	if err != nil {
+		panic(err)
+	}
+
+	return tmpl
+}
+
+func getMagicLinkCallbackUrl() *url.URL {
+	url, err := url.Parse(os.Getenv("MAGIC_LINK_CALLBACK_URL"))
+	if err != nil {
+		panic(err)
+	}
+
+	return url
+}
+
+var (
+	magicLoginTemplate   = getMagicLoginTemplate()
+	magicLinkCallbackUrl = getMagicLinkCallbackUrl()
+)
+
+type magicLoginEmailData struct {
+	Url string
+}
+
+// Sends a one-click login link to the user with the email
+func SendMagicLinkEmail(userEmail string) error {
+	var user model.User
+	result := database.Instance().
+		Model(&model.User{}).
+		Where("email = ?", userEmail).
+		First(&user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return ErrUnknownEmail
+		}
+		return result.Error
+	}
+
+	token, err := token.CreateToken(user.ID, token.MagicLogin)
+	if err != nil {
+		return err
+	}
+
+	url := *magicLinkCallbackUrl
+	q := url.Query()
+	q.Set("user_id", strconv.Itoa(int(user.ID)))
+	q.Set("token", token)
+	url.RawQuery = q.Encode()
+
+	content, err := utils.CreateHtml(
+		magicLoginTemplate,
+		magicLoginEmailData{Url: url.String()},
+	)
+	if err != nil {
+		return err
+	}
+
+	err = email.Send(userEmail, "Your login link", content)
+	if err != nil {
+		return ErrCannotSendEmail
+	}
+
+	return nil
+}
+
+// Consumes the login token of the link, which can only be used once.
+// As the link proves the user owns its email, the user is activated.
+// Returns the user, whose login may still need a second factor.
+func ConfirmMagicLink(userId int32, loginToken string) (model.User, error) {
+	var user model.User
+
+	err := database.Instance().Transaction(func(tx *gorm.DB) error {
+		var t model.Token
+		result := tx.
+			Model(&model.Token{}).
+			Where("user_id = ? AND purpose = ?", userId, token.MagicLogin.String()).
+			First(&t)
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return ErrInvalidToken
+			}
+			return result.Error
+		}
+
+		if !token.VerifyHash(loginToken, t.TokenHash) {
+			return ErrInvalidToken
+		}
+
+		if t.ExpiresAt.Before(time.Now()) {
+			return ErrExpiredToken
+		}
+
+		// The token is deleted first, so that concurrent confirmations cannot both succeed
+		result = tx.
+			Where("id = ? AND token_hash = ?", t.ID, t.TokenHash).
+			Delete(&model.Token{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrInvalidToken
+		}
+
+		result = tx.
+			Model(&model.User{}).
+			Where("id = ? AND is_activated = ?", userId, false).
+			Update("is_activated", true)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		return tx.Where("id = ?", userId).First(&user).Error
+	})
+	if err != nil {
+		return model.User{}, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,424 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"study-planner-api/internal/audit"
+	"study-planner-api/internal/auth/token"
+	db "study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/utils/totp"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	totpIssuer = "Study Planner"
+
+	recoveryCodeCount = 10
+	// Random bytes of a recovery code, shown as 2 groups of 5 hex characters
+	recoveryCodeLength = 5
+
+	// Invalid codes after which a login must start over
+	mfaChallengeMaxFailures = 5
+)
+
+var (
+	ErrMfaAlreadyEnabled = errors.New("mfa already enabled")
+	ErrMfaNotEnabled     = errors.New("mfa not enabled")
+	ErrMfaNotSetUp       = errors.New("mfa not set up")
+	ErrInvalidMfaCode    = errors.New("invalid mfa code")
+)
+
+type TotpEnrollment struct {
+	// Base32 encoded, for authenticator apps which cannot scan the URI
+	Secret string
+	URI    string
+}
+
+// Starts the TOTP enrollment of the user with a new secret.
+// 2FA is only enabled once the enrollment is confirmed with a code, see ConfirmTotp.
+func SetupTotp(userID int32) (TotpEnrollment, error) {
+	var user model.User
+	result := db.Instance().
+		Model(&model.User{}).
+		Where("id = ?", userID).
+		First(&user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return TotpEnrollment{}, ErrUserNotFound
+		}
+		return TotpEnrollment{}, result.Error
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return TotpEnrollment{}, err
+	}
+
+	encryptedSecret, err := token.EncryptSecret(secret)
+	if err != nil {
+		return TotpEnrollment{}, err
+	}
+
+	err = db.Instance().Transaction(func(tx *gorm.DB) error {
+		var confirmed int64
+		err := tx.
+			Model(&model.UserTotp{}).
+			Where("user_id = ? AND confirmed_at IS NOT NULL", userID).
+			Count(&confirmed).
+			Error
+		if err != nil {
+			return err
+		}
+		if confirmed > 0 {
+			return ErrMfaAlreadyEnabled
+		}
+
+		// Replaces a previous enrollment which was never confirmed
+		err = tx.Where("user_id = ?", userID).Delete(&model.UserTotp{}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.
+			Select("UserID", "Secret").
+			Create(&model.UserTotp{UserID: userID, Secret: encryptedSecret}).
+			Error
+	})
+	if err != nil {
+		return TotpEnrollment{}, err
+	}
+
+	var account string
+	if user.Email != nil {
+		account = *user.Email
+	}
+
+	return TotpEnrollment{
+		Secret: secret,
+		URI:    totp.URI(totpIssuer, account, secret),
+	}, nil
+}
+
+// Enables 2FA after the user proved its authenticator works with a code.
+// Returns the recovery codes, which cannot be retrieved again.
+func ConfirmTotp(userID int32, code string, client Client) ([]string, error) {
+	var recoveryCodes []string
+
+	err := db.Instance().Transaction(func(tx *gorm.DB) error {
+		var userTotp model.UserTotp
+		result := tx.Where("user_id = ?", userID).First(&userTotp)
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return ErrMfaNotSetUp
+			}
+			return result.Error
+		}
+		if userTotp.ConfirmedAt != nil {
+			return ErrMfaAlreadyEnabled
+		}
+
+		step, err := validateTotpCode(userTotp, code)
+		if err != nil {
+			return err
+		}
+
+		err = tx.
+			Model(&model.UserTotp{}).
+			Where("user_id = ?", userID).
+			Updates(map[string]any{
+				"confirmed_at":   time.Now(),
+				"last_used_step": step,
+			}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		recoveryCodes, err = replaceRecoveryCodes(tx, userID)
+		if err != nil {
+			return err
+		}
+
+		return audit.RecordTx(tx, audit.Event{
+			UserID: &userID,
+			Type:   audit.EventMfaEnabled,
+			Client: &client,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return recoveryCodes, nil
+}
+
+// Disables 2FA, after verifying a code of the user
+func DisableTotp(userID int32, code string, client Client) error {
+	return db.Instance().Transaction(func(tx *gorm.DB) error {
+		err := verifyMfaCode(tx, userID, code, client)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("user_id = ?", userID).Delete(&model.UserTotp{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("user_id = ?", userID).Delete(&model.UserRecoveryCode{}).Error
+		if err != nil {
+			return err
+		}
+
+		return audit.RecordTx(tx, audit.Event{
+			UserID: &userID,
+			Type:   audit.EventMfaDisabled,
+			Client: &client,
+		})
+	})
+}
+
+// Checks whether the user confirmed its TOTP enrollment, and must provide a code to log in
+func IsMfaEnabled(userID int32) (bool, error) {
+	var count int64
+	err := db.Instance().
+		Model(&model.UserTotp{}).
+		Where("user_id = ? AND confirmed_at IS NOT NULL", userID).
+		Count(&count).
+		Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Starts a login of the user waiting for its second factor.
+// Returns the challenge token, exchanged for auth tokens with a code at CompleteMfaLogin.
+func StartMfaChallenge(userID int32) (token.JwtToken, error) {
+	challengeToken, err := token.CreateMfaChallengeToken(userID)
+	if err != nil {
+		return token.JwtToken{}, err
+	}
+
+	err = db.Instance().Create(&model.MfaChallenge{
+		ID:        challengeToken.ID,
+		UserID:    userID,
+		ExpiresAt: challengeToken.Expiry.Time(),
+	}).Error
+	if err != nil {
+		return token.JwtToken{}, err
+	}
+
+	return challengeToken, nil
+}
+
+// Completes a login waiting for its second factor.
+// "code" is either a TOTP code or a recovery code, which can only be used once.
+// Invalid codes are throttled per user as passwords are, see VerifyLoginInfo,
+// and the challenge can only be completed once, before mfaChallengeMaxFailures invalid codes.
+func CompleteMfaLogin(challengeToken string, code string, client Client) (accessToken token.JwtToken, refreshToken token.JwtToken, err error) {
+	challenge, err := token.ValidateMfaChallengeToken(challengeToken)
+	if err != nil {
+		return token.JwtToken{}, token.JwtToken{}, ErrInvalidToken
+	}
+
+	var user model.User
+	result := db.Instance().
+		Joins("JOIN mfa_challenge ON mfa_challenge.user_id = user.id").
+		Where("mfa_challenge.id = ? AND mfa_challenge.user_id = ? AND mfa_challenge.expires_at > ?", challenge.ID, challenge.UserID, time.Now()).
+		Limit(1).
+		Find(&user)
+	if result.Error != nil {
+		return token.JwtToken{}, token.JwtToken{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return token.JwtToken{}, token.JwtToken{}, ErrInvalidToken
+	}
+
+	attempt, err := reserveMfaAttempt(user.ID, client.IPAddress)
+	if err != nil {
+		return token.JwtToken{}, token.JwtToken{}, err
+	}
+
+	err = db.Instance().Transaction(func(tx *gorm.DB) error {
+		// Consumed along with the code, a concurrent completion of the challenge fails
+		result := tx.
+			Where("id = ?", challenge.ID).
+			Delete(&model.MfaChallenge{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrInvalidToken
+		}
+
+		return verifyMfaCode(tx, user.ID, code, client)
+	})
+	if err != nil {
+		if errors.Is(err, ErrInvalidMfaCode) {
+			failErr := failMfaChallenge(challenge.ID)
+			if failErr != nil {
+				return token.JwtToken{}, token.JwtToken{}, failErr
+			}
+
+			recordErr := recordLoginFailure(&user, attempt, loginFailureInvalidMfaCode, client)
+			if recordErr != nil {
+				return token.JwtToken{}, token.JwtToken{}, recordErr
+			}
+		}
+		return token.JwtToken{}, token.JwtToken{}, err
+	}
+
+	err = releaseLoginAttempt(attempt)
+	if err != nil {
+		return token.JwtToken{}, token.JwtToken{}, err
+	}
+
+	return StartSession(user.ID, LoginMfa, client)
+}
+
+// Counts an invalid code for the challenge, which is deleted after mfaChallengeMaxFailures of them
+func failMfaChallenge(challengeID string) error {
+	return db.Instance().Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Model(&model.MfaChallenge{}).
+			Where("id = ?", challengeID).
+			Update("failures", gorm.Expr("failures + 1")).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return tx.
+			Where("id = ? AND failures >= ?", challengeID, mfaChallengeMaxFailures).
+			Delete(&model.MfaChallenge{}).
+			Error
+	})
+}
+
+// Deletes the MFA challenges which expired without being completed.
+// Returns the number of deleted challenges.
+func PurgeExpiredMfaChallenges() (int64, error) {
+	result := db.Instance().
+		Where("expires_at < ?", time.Now()).
+		Delete(&model.MfaChallenge{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// Verifies a TOTP or recovery code of a user with 2FA enabled, and consumes it
+func verifyMfaCode(tx *gorm.DB, userID int32, code string, client Client) error {
+	var userTotp model.UserTotp
+	result := tx.
+		Where("user_id = ? AND confirmed_at IS NOT NULL", userID).
+		First(&userTotp)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return ErrMfaNotEnabled
+		}
+		return result.Error
+	}
+
+	code = strings.TrimSpace(code)
+	if len(code) != totp.Digits {
+		return useRecoveryCode(tx, userID, code, client)
+	}
+
+	step, err := validateTotpCode(userTotp, code)
+	if err != nil {
+		return err
+	}
+
+	// A code is valid for a few steps, only its first use is accepted
+	result = tx.
+		Model(&model.UserTotp{}).
+		Where("user_id = ? AND (last_used_step IS NULL OR last_used_step < ?)", userID, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrInvalidMfaCode
+	}
+
+	return nil
+}
+
+// Validates the code against the secret of the enrollment.
+// Returns the time step the code was generated for.
+func validateTotpCode(userTotp model.UserTotp, code string) (int64, error) {
+	secret, err := token.DecryptSecret(userTotp.Secret)
+	if err != nil {
+		return 0, err
+	}
+
+	step, ok := totp.Validate(secret, code, time.Now())
+	if !ok {
+		return 0, ErrInvalidMfaCode
+	}
+
+	return step, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
+
+func useRecoveryCode(tx *gorm.DB, userID int32, code string, client Client) error {
+	result := tx.
+		Model(&model.UserRecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, token.HashToken(normalizeRecoveryCode(code))).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrInvalidMfaCode
+	}
+
+	return audit.RecordTx(tx, audit.Event{
+		UserID: &userID,
+		Type:   audit.EventRecoveryCodeUsed,
+		Client: &client,
+	})
+}
+
+// Replaces the recovery codes of the user with new ones.
+// Returns the new codes, only their hashes are stored.
+func replaceRecoveryCodes(tx *gorm.DB, userID int32) ([]string, error) {
+	err := tx.Where("user_id = ?", userID).Delete(&model.UserRecoveryCode{}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, recoveryCodeCount)
+	rows := make([]model.UserRecoveryCode, recoveryCodeCount)
+	for i := range codes {
+		code, err := token.GenerateRandomToken(recoveryCodeLength)
+		if err != nil {
+			return nil, err
+		}
+
+		codes[i] = code[:recoveryCodeLength] + "-" + code[recoveryCodeLength:]
+		rows[i] = model.UserRecoveryCode{
+			UserID:   userID,
+			CodeHash: token.HashToken(code),
+		}
+	}
+
+	err = tx.Select("UserID", "CodeHash").Create(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
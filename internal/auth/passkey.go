@@ -0,0 +1,322 @@
+package auth
+
+import (
+	"errors"
+	"strconv"
+	"study-planner-api/internal/audit"
+	"study-planner-api/internal/auth/token"
+	"study-planner-api/internal/auth/webauthn"
+	db "study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrPasskeyNotFound = errors.New("passkey not found")
+	ErrPasskeyExists   = errors.New("passkey already registered")
+	ErrInvalidPasskey  = errors.New("invalid passkey")
+)
+
+// Challenges are carried by stateless tokens, the ones already answered are remembered until the tokens expire,
+// so that a ceremony cannot be replayed.
+// A token expires within the ceremony timeout of its use, the margin covers the clock skew tolerated by its validation.
+const usedPasskeyChallengeRetention = webauthn.CeremonyTimeout + time.Minute
+
+// Encodes the user ID as the user handle of its passkeys
+func userHandle(userID int32) []byte {
+	return []byte(strconv.Itoa(int(userID)))
+}
+
+// Starts the registration of a passkey of the user.
+// Returns the options for the client and the token to complete the registration with.
+func BeginPasskeyRegistration(userID int32) (webauthn.CreationOptions, token.JwtToken, error) {
+	var user model.User
+	result := db.Instance().
+		Model(&model.User{}).
+		Where("id = ?", userID).
+		First(&user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return webauthn.CreationOptions{}, token.JwtToken{}, ErrUserNotFound
+		}
+		return webauthn.CreationOptions{}, token.JwtToken{}, result.Error
+	}
+
+	passkeys, err := GetPasskeys(userID)
+	if err != nil {
+		return webauthn.CreationOptions{}, token.JwtToken{}, err
+	}
+
+	exclude := make([][]byte, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		id, err := webauthn.Encoding.DecodeString(passkey.CredentialID)
+		if err != nil {
+			return webauthn.CreationOptions{}, token.JwtToken{}, err
+		}
+		exclude = append(exclude, id)
+	}
+
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return webauthn.CreationOptions{}, token.JwtToken{}, err
+	}
+
+	challengeToken, err := token.CreatePasskeyRegisterToken(token.PasskeyChallenge{
+		Challenge: challenge,
+		UserID:    userID,
+	})
+	if err != nil {
+		return webauthn.CreationOptions{}, token.JwtToken{}, err
+	}
+
+	var name string
+	if user.Email != nil {
+		name = *user.Email
+	}
+
+	options := webauthn.GetRelyingParty().CreationOptions(challenge, webauthn.UserEntity{
+		ID:          webauthn.Encoding.EncodeToString(userHandle(userID)),
+		Name:        name,
+		DisplayName: name,
+	}, exclude)
+
+	return options, challengeToken, nil
+}
+
+// Completes the registration of a passkey of the user, started by BeginPasskeyRegistration
+func FinishPasskeyRegistration(
+	userID int32,
+	challengeToken string,
+	name *string,
+	response webauthn.AttestationResponse,
+	client Client,
+) (model.WebauthnCredential, error) {
+	challenge, err := token.ValidatePasskeyRegisterToken(challengeToken)
+	if err != nil || challenge.UserID != userID {
+		return model.WebauthnCredential{}, ErrInvalidToken
+	}
+
+	err = usePasskeyChallenge(challenge.Challenge)
+	if err != nil {
+		return model.WebauthnCredential{}, err
+	}
+
+	credential, err := webauthn.GetRelyingParty().VerifyRegistration(challenge.Challenge, response)
+	if err != nil {
+		log.Debug().Err(err).Msg("passkey registration rejected")
+		return model.WebauthnCredential{}, ErrInvalidPasskey
+	}
+
+	passkey := model.WebauthnCredential{
+		UserID:       userID,
+		CredentialID: webauthn.Encoding.EncodeToString(credential.ID),
+		PublicKey:    webauthn.Encoding.EncodeToString(credential.PublicKey),
+		SignCount:    int64(credential.SignCount),
+		Name:         name,
+	}
+
+	err = db.Instance().Transaction(func(tx *gorm.DB) error {
+		var count int64
+		err := tx.
+			Model(&model.WebauthnCredential{}).
+			Where("credential_id = ?", passkey.CredentialID).
+			Count(&count).
+			Error
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrPasskeyExists
+		}
+
+		err = tx.Create(&passkey).Error
+		if err != nil {
+			return err
+		}
+
+		return audit.RecordTx(tx, audit.Event{
+			UserID:  &userID,
+			Type:    audit.EventPasskeyAdded,
+			Details: map[string]any{"passkey_id": passkey.ID},
+			Client:  &client,
+		})
+	})
+	if err != nil {
+		return model.WebauthnCredential{}, err
+	}
+
+	return passkey, nil
+}
+
+// Gets the passkeys of the user, oldest first
+func GetPasskeys(userID int32) ([]model.WebauthnCredential, error) {
+	var passkeys []model.WebauthnCredential
+	result := db.Instance().
+		Model(&model.WebauthnCredential{}).
+		Where("user_id = ?", userID).
+		Order("id").
+		Find(&passkeys)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return passkeys, nil
+}
+
+func RemovePasskeyOfUser(passkeyID int32, userID int32, client Client) error {
+	return db.Instance().Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Where("id = ? AND user_id = ?", passkeyID, userID).
+			Delete(&model.WebauthnCredential{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrPasskeyNotFound
+		}
+
+		return audit.RecordTx(tx, audit.Event{
+			UserID:  &userID,
+			Type:    audit.EventPasskeyRemoved,
+			Details: map[string]any{"passkey_id": passkeyID},
+			Client:  &client,
+		})
+	})
+}
+
+// Starts a passkey login.
+// Returns the options for the client and the token to complete the login with.
+func BeginPasskeyLogin() (webauthn.RequestOptions, token.JwtToken, error) {
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return webauthn.RequestOptions{}, token.JwtToken{}, err
+	}
+
+	challengeToken, err := token.CreatePasskeyLoginToken(token.PasskeyChallenge{Challenge: challenge})
+	if err != nil {
+		return webauthn.RequestOptions{}, token.JwtToken{}, err
+	}
+
+	return webauthn.GetRelyingParty().RequestOptions(challenge), challengeToken, nil
+}
+
+// Completes a passkey login started by BeginPasskeyLogin, with the credential "credentialID".
+// Returns the tokens of a new session of the owner of the passkey.
+func FinishPasskeyLogin(
+	challengeToken string,
+	credentialID string,
+	response webauthn.AssertionResponse,
+	client Client,
+) (accessToken token.JwtToken, refreshToken token.JwtToken, err error) {
+	challenge, err := token.ValidatePasskeyLoginToken(challengeToken)
+	if err != nil {
+		return token.JwtToken{}, token.JwtToken{}, ErrInvalidToken
+	}
+
+	err = usePasskeyChallenge(challenge.Challenge)
+	if err != nil {
+		return token.JwtToken{}, token.JwtToken{}, err
+	}
+
+	var passkey model.WebauthnCredential
+	result := db.Instance().
+		Model(&model.WebauthnCredential{}).
+		Where("credential_id = ?", credentialID).
+		First(&passkey)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return token.JwtToken{}, token.JwtToken{}, ErrInvalidPasskey
+		}
+		return token.JwtToken{}, token.JwtToken{}, result.Error
+	}
+
+	if response.UserHandle != nil && string(response.UserHandle) != string(userHandle(passkey.UserID)) {
+		return token.JwtToken{}, token.JwtToken{}, ErrInvalidPasskey
+	}
+
+	publicKey, err := webauthn.Encoding.DecodeString(passkey.PublicKey)
+	if err != nil {
+		return token.JwtToken{}, token.JwtToken{}, err
+	}
+
+	signCount, err := webauthn.GetRelyingParty().VerifyAssertion(challenge.Challenge, webauthn.Credential{
+		PublicKey: publicKey,
+		SignCount: uint32(passkey.SignCount),
+	}, response)
+	if err != nil {
+		log.Debug().Err(err).Msg("passkey login rejected")
+
+		if errors.Is(err, webauthn.ErrSignCountRegression) {
+			log.Warn().Int32("user_id", passkey.UserID).Int32("passkey_id", passkey.ID).Msg(err.Error())
+
+			auditErr := audit.Record(audit.Event{
+				UserID:  &passkey.UserID,
+				Type:    audit.EventPasskeyCloneDetected,
+				Details: map[string]any{"passkey_id": passkey.ID},
+				Client:  &client,
+			})
+			if auditErr != nil {
+				return token.JwtToken{}, token.JwtToken{}, auditErr
+			}
+		}
+
+		auditErr := recordLoginFailed(&passkey.UserID, loginFailureInvalidPasskey, client)
+		if auditErr != nil {
+			return token.JwtToken{}, token.JwtToken{}, auditErr
+		}
+
+		return token.JwtToken{}, token.JwtToken{}, ErrInvalidPasskey
+	}
+
+	// The sign count is checked again, in case the passkey has been used concurrently
+	result = db.Instance().
+		Model(&model.WebauthnCredential{}).
+		Where("id = ? AND sign_count = ?", passkey.ID, passkey.SignCount).
+		Updates(map[string]any{
+			"sign_count":   int64(signCount),
+			"last_used_at": time.Now(),
+		})
+	if result.Error != nil {
+		return token.JwtToken{}, token.JwtToken{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return token.JwtToken{}, token.JwtToken{}, ErrInvalidPasskey
+	}
+
+	return StartSession(passkey.UserID, LoginPasskey, client)
+}
+
+// Marks the challenge of a ceremony as answered, fails with ErrInvalidToken if it already was
+func usePasskeyChallenge(challenge string) error {
+	result := db.Instance().
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.UsedPasskeyChallenge{
+			Challenge: challenge,
+			ExpiresAt: time.Now().Add(usedPasskeyChallengeRetention),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrInvalidToken
+	}
+
+	return nil
+}
+
+// Deletes the answered challenges whose tokens have expired.
+// Returns the number of deleted challenges.
+func PurgeUsedPasskeyChallenges() (int64, error) {
+	result := db.Instance().
+		Where("expires_at < ?", time.Now()).
+		Delete(&model.UsedPasskeyChallenge{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"errors"
+	"study-planner-api/internal/audit"
+	"study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/user"
+
+	"gorm.io/gorm"
+)
+
+// Changes the password of the user, who must know the current one.
+// Wrong current passwords count as failed logins of the account, so they are throttled the same way,
+// and users without a password must set one with a password reset instead.
+// Every session of the user but "sessionID" is revoked.
+func ChangePassword(userID int32, sessionID string, currentPassword string, newPassword string, client Client) error {
+	var u model.User
+	result := database.Instance().
+		Where("id = ?", userID).
+		First(&u)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return ErrUserNotFound
+		}
+		return result.Error
+	}
+
+	if u.Password == nil || u.Email == nil {
+		return ErrUserHasNoPassword
+	}
+
+	_, err := VerifyLoginInfo(LoginInfo{Email: *u.Email, Password: currentPassword}, client)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := user.HashPassword(newPassword, *u.Email)
+	if err != nil {
+		return err
+	}
+
+	err = database.Instance().Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Model(&model.User{}).
+			Where("id = ?", userID).
+			Update("password", hashedPassword)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.
+			Where("user_id = ? AND family_id <> ?", userID, sessionID).
+			Delete(&model.UserSession{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		return audit.RecordTx(tx, audit.Event{
+			UserID: &userID,
+			Type:   audit.EventPasswordChanged,
+			Details: map[string]any{
+				"revoked_sessions": result.RowsAffected,
+			},
+			Client: &client,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	forgetTokenStates(userID)
+
+	return nil
+}
@@ -7,12 +7,14 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"study-planner-api/internal/audit"
 	"study-planner-api/internal/auth/token"
 	"study-planner-api/internal/database"
 	"study-planner-api/internal/model"
 	"study-planner-api/internal/user"
 	"study-planner-api/internal/utils"
 	"study-planner-api/internal/utils/email"
+	"study-planner-api/internal/validator/passwordpolicy"
 	"time"
 
 	"gorm.io/gorm"
@@ -53,7 +55,7 @@ type passwordResetEmailData struct {
 	Url string
 }
 
-func SendPasswordResetEmail(userEmail string) error {
+func SendPasswordResetEmail(userEmail string, client Client) error {
 	var user model.User
 	result := database.Instance().
 		Model(&model.User{}).
@@ -71,6 +73,15 @@ func SendPasswordResetEmail(userEmail string) error {
 		return err
 	}
 
+	err = audit.Record(audit.Event{
+		UserID: &user.ID,
+		Type:   audit.EventPasswordResetRequested,
+		Client: &client,
+	})
+	if err != nil {
+		return err
+	}
+
 	url := passwordResetCallbackUrl
 	q := url.Query()
 	q.Set("user_id", strconv.Itoa(int(user.ID)))
@@ -93,7 +104,7 @@ func SendPasswordResetEmail(userEmail string) error {
 	return nil
 }
 
-func ResetPassword(userId int32, resetToken string, newPassword string) error {
+func ResetPassword(userId int32, resetToken string, newPassword string, client Client) error {
 	var t model.Token
 	result := database.Instance().
 		Select("token.*").
@@ -118,6 +129,10 @@ func ResetPassword(userId int32, resetToken string, newPassword string) error {
 
 	err := user.UpdatePassword(userId, newPassword)
 	if err != nil {
+		var policyErr *passwordpolicy.Error
+		if errors.As(err, &policyErr) {
+			return err
+		}
 		return errors.New("cannot update password, something went wrong")
 	}
 
@@ -132,7 +147,17 @@ func ResetPassword(userId int32, resetToken string, newPassword string) error {
 		return errors.New("cannot update, something went wrong")
 	}
 
-	return nil
+	// Whoever knew the old password is logged out
+	err = RevokeAllTokens(userId)
+	if err != nil {
+		return err
+	}
+
+	return audit.Record(audit.Event{
+		UserID: &userId,
+		Type:   audit.EventPasswordReset,
+		Client: &client,
+	})
 }
 
 func VerifyPasswordResetToken(userId int32, resetToken string) error {
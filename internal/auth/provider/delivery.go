@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"study-planner-api/internal/auth/token"
+	"sync"
+)
+
+const (
+	// The result is posted to the window which opened the popup
+	DeliveryPopup = "popup"
+	// The browser is redirected to the frontend, with an exchange code
+	DeliveryRedirect = "redirect"
+	// The app is opened by a link of its custom scheme, with an exchange code
+	DeliveryDeepLink = "deep_link"
+)
+
+var (
+	ErrInvalidDeliveryMode = errors.New("invalid delivery mode")
+	ErrUnallowedReturnTo   = errors.New("return_to is not allowed")
+)
+
+// Where the results of authorizations may be delivered
+type DeliveryPolicy struct {
+	// Origins allowed to open popups, the result is only posted to them
+	PopupOrigins []string
+	// Frontend URLs allowed to be redirected to, matched without their query
+	RedirectUrls []string
+	// Custom schemes of the apps allowed to be opened by deep links
+	DeepLinkSchemes []string
+}
+
+// Checks that the result of the authorization of "app" can be delivered where it asks.
+// Returns the application with its default mode and return_to set.
+func (p DeliveryPolicy) Validate(app token.RequestApplication) (token.RequestApplication, error) {
+	if app.Mode == "" {
+		app.Mode = DeliveryPopup
+	}
+
+	switch app.Mode {
+	case DeliveryPopup:
+		// Without return_to, a single allowed origin is the only possible opener
+		if app.ReturnTo == "" && len(p.PopupOrigins) == 1 {
+			app.ReturnTo = p.PopupOrigins[0]
+		}
+		if !slices.Contains(p.PopupOrigins, app.ReturnTo) {
+			return token.RequestApplication{}, ErrUnallowedReturnTo
+		}
+	case DeliveryRedirect:
+		returnTo, err := url.Parse(app.ReturnTo)
+		if err != nil || returnTo.Fragment != "" {
+			return token.RequestApplication{}, ErrUnallowedReturnTo
+		}
+
+		returnTo.RawQuery = ""
+		if !slices.Contains(p.RedirectUrls, returnTo.String()) {
+			return token.RequestApplication{}, ErrUnallowedReturnTo
+		}
+	case DeliveryDeepLink:
+		returnTo, err := url.Parse(app.ReturnTo)
+		if err != nil || returnTo.Scheme == "http" || returnTo.Scheme == "https" ||
+			!slices.Contains(p.DeepLinkSchemes, returnTo.Scheme) {
+			return token.RequestApplication{}, ErrUnallowedReturnTo
+		}
+	default:
+		return token.RequestApplication{}, ErrInvalidDeliveryMode
+	}
+
+	return app, nil
+}
+
+var (
+	deliveryPolicy     DeliveryPolicy
+	deliveryPolicyOnce sync.Once
+)
+
+// Gets the delivery policy configured by env vars, loaded on first use.
+// Popups are allowed for the origins of ALLOW_ORIGINS, but never for any origin even with ALLOW_ALL.
+func GetDeliveryPolicy() DeliveryPolicy {
+	deliveryPolicyOnce.Do(func() {
+		deliveryPolicy = DeliveryPolicy{
+			PopupOrigins:    strings.Fields(os.Getenv("ALLOW_ORIGINS")),
+			RedirectUrls:    strings.Fields(os.Getenv("OAUTH_REDIRECT_URLS")),
+			DeepLinkSchemes: strings.Fields(os.Getenv("OAUTH_DEEP_LINK_SCHEMES")),
+		}
+	})
+
+	return deliveryPolicy
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"errors"
+	"study-planner-api/internal/auth/token"
+	"testing"
+)
+
+func TestDeliveryPolicyValidate(t *testing.T) {
+	policy := DeliveryPolicy{
+		PopupOrigins:    []string{"https://app.example.com"},
+		RedirectUrls:    []string{"https://app.example.com/auth/done"},
+		DeepLinkSchemes: []string{"studyplanner"},
+	}
+
+	tests := []struct {
+		name    string
+		app     token.RequestApplication
+		wantErr error
+	}{
+		{name: "default popup", app: token.RequestApplication{}},
+		{name: "popup of another origin", wantErr: ErrUnallowedReturnTo,
+			app: token.RequestApplication{Mode: DeliveryPopup, ReturnTo: "https://evil.example.com"}},
+		{name: "redirect with query",
+			app: token.RequestApplication{Mode: DeliveryRedirect, ReturnTo: "https://app.example.com/auth/done?next=/tasks"}},
+		{name: "redirect to another path", wantErr: ErrUnallowedReturnTo,
+			app: token.RequestApplication{Mode: DeliveryRedirect, ReturnTo: "https://app.example.com/other"}},
+		{name: "redirect with userinfo", wantErr: ErrUnallowedReturnTo,
+			app: token.RequestApplication{Mode: DeliveryRedirect, ReturnTo: "https://app.example.com@evil.example.com/auth/done"}},
+		{name: "deep link",
+			app: token.RequestApplication{Mode: DeliveryDeepLink, ReturnTo: "studyplanner://auth"}},
+		{name: "deep link of another scheme", wantErr: ErrUnallowedReturnTo,
+			app: token.RequestApplication{Mode: DeliveryDeepLink, ReturnTo: "otherapp://auth"}},
+		{name: "deep link over https", wantErr: ErrUnallowedReturnTo,
+			app: token.RequestApplication{Mode: DeliveryDeepLink, ReturnTo: "https://app.example.com/auth/done"}},
+		{name: "unknown mode", wantErr: ErrInvalidDeliveryMode,
+			app: token.RequestApplication{Mode: "email"}},
+	}
+
+	for _, tt := range tests {
+		app, err := policy.Validate(tt.app)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("Validate() with %s error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+		if err == nil && app.ReturnTo == "" {
+			t.Errorf("Validate() with %s did not set return_to", tt.name)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"errors"
+	"study-planner-api/internal/audit"
+	db "study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/user/profile"
+	"study-planner-api/internal/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrUnverifiedEmail = errors.New("email of the identity is not verified")
+	// The account with the email of the identity never verified it, so it may not belong to the same person
+	ErrUnverifiedAccount = errors.New("account with the email of the identity is not verified")
+	ErrIdentityLinked    = errors.New("identity is linked to another user")
+	ErrIdentityNotFound  = errors.New("identity not found")
+	ErrLastLoginMethod   = errors.New("identity is the last login method of the user")
+)
+
+// Gets the user of an identity at the provider.
+// A new identity is linked to the user with the same email, or to a new user, so its email must be verified.
+// It is only linked to an existing user whose email is verified too, otherwise the user must link it
+// explicitly from an authenticated session, with LinkIdentity.
+// The events are recorded with the client "client" which logged in.
+func ResolveUser(providerName string, identity Identity, client audit.Client) (model.User, error) {
+	var user model.User
+	err := db.Instance().Transaction(func(tx *gorm.DB) error {
+		existing, found, err := findIdentity(tx, providerName, identity.Subject)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			err = touchIdentity(tx, existing, identity)
+			if err != nil {
+				return err
+			}
+			return tx.Where("id = ?", existing.UserID).First(&user).Error
+		}
+
+		if !identity.EmailVerified || identity.Email == "" {
+			return ErrUnverifiedEmail
+		}
+
+		result := tx.
+			Where("email = ?", identity.Email).
+			Limit(1).
+			Find(&user)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			// The provider verified the email, so the account is activated
+			user = model.User{
+				Email:       &identity.Email,
+				IsActivated: true,
+			}
+			result = tx.
+				Select("email", "is_activated").
+				Create(&user)
+			if result.Error != nil {
+				return result.Error
+			}
+
+			err = profile.ApplyTx(tx, user.ID, profile.FromClaims(profile.Claims{
+				Name:       identity.Name,
+				PictureUrl: identity.PictureUrl,
+				Locale:     identity.Locale,
+				Zoneinfo:   identity.Zoneinfo,
+			}))
+			if err != nil {
+				return err
+			}
+
+			err = audit.RecordTx(tx, audit.Event{
+				UserID:  &user.ID,
+				Type:    audit.EventRegistered,
+				Details: map[string]any{"provider": providerName},
+				Client:  &client,
+			})
+			if err != nil {
+				return err
+			}
+		} else if !user.IsActivated {
+			return ErrUnverifiedAccount
+		}
+
+		return createIdentity(tx, user.ID, providerName, identity, client)
+	})
+	if err != nil {
+		return model.User{}, err
+	}
+
+	return user, nil
+}
+
+// Links an identity at the provider to the user, whatever its email.
+// Linking an identity which is already linked to the user does nothing.
+func LinkIdentity(userID int32, providerName string, identity Identity, client audit.Client) error {
+	return db.Instance().Transaction(func(tx *gorm.DB) error {
+		existing, found, err := findIdentity(tx, providerName, identity.Subject)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			if existing.UserID != userID {
+				return ErrIdentityLinked
+			}
+			return touchIdentity(tx, existing, identity)
+		}
+
+		return createIdentity(tx, userID, providerName, identity, client)
+	})
+}
+
+// Unlinks an identity from the user.
+// The user must keep another way to log in: a password, a passkey or another identity.
+// Login links are not counted, since the email may be the one of the identity's account.
+func UnlinkIdentityOfUser(identityID int32, userID int32, client audit.Client) error {
+	return db.Instance().Transaction(func(tx *gorm.DB) error {
+		var identity model.ExternalIdentity
+		result := tx.
+			Where("id = ? AND user_id = ?", identityID, userID).
+			Limit(1).
+			Find(&identity)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrIdentityNotFound
+		}
+
+		hasOtherMethod, err := hasOtherLoginMethod(tx, userID, identityID)
+		if err != nil {
+			return err
+		}
+		if !hasOtherMethod {
+			return ErrLastLoginMethod
+		}
+
+		result = tx.Delete(&identity)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		return audit.RecordTx(tx, audit.Event{
+			UserID: &userID,
+			Type:   audit.EventIdentityUnlinked,
+			Details: map[string]any{
+				"identity_id": identityID,
+				"provider":    identity.Provider,
+			},
+			Client: &client,
+		})
+	})
+}
+
+// Gets the identities linked to the user, oldest first
+func GetIdentities(userID int32) ([]model.ExternalIdentity, error) {
+	var identities []model.ExternalIdentity
+	result := db.Instance().
+		Where("user_id = ?", userID).
+		Order("id").
+		Find(&identities)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return identities, nil
+}
+
+func findIdentity(tx *gorm.DB, providerName string, subject string) (model.ExternalIdentity, bool, error) {
+	var identity model.ExternalIdentity
+	result := tx.
+		Where("provider = ? AND subject = ?", providerName, subject).
+		Limit(1).
+		Find(&identity)
+	if result.Error != nil {
+		return model.ExternalIdentity{}, false, result.Error
+	}
+
+	return identity, result.RowsAffected > 0, nil
+}
+
+// Records a login with the identity
+func touchIdentity(tx *gorm.DB, existing model.ExternalIdentity, identity Identity) error {
+	return tx.
+		Model(&existing).
+		Updates(map[string]any{
+			"email":        identity.Email,
+			"last_used_at": time.Now(),
+		}).
+		Error
+}
+
+func createIdentity(tx *gorm.DB, userID int32, providerName string, identity Identity, client audit.Client) error {
+	externalIdentity := model.ExternalIdentity{
+		UserID:     userID,
+		Provider:   providerName,
+		Subject:    identity.Subject,
+		Email:      &identity.Email,
+		LastUsedAt: utils.Ptr(time.Now()),
+	}
+	result := tx.Create(&externalIdentity)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return audit.RecordTx(tx, audit.Event{
+		UserID: &userID,
+		Type:   audit.EventIdentityLinked,
+		Details: map[string]any{
+			"identity_id": externalIdentity.ID,
+			"provider":    providerName,
+		},
+		Client: &client,
+	})
+}
+
+// Whether the user can log in without the identity "identityID"
+func hasOtherLoginMethod(tx *gorm.DB, userID int32, identityID int32) (bool, error) {
+	var passwords int64
+	result := tx.
+		Model(&model.User{}).
+		Where("id = ? AND password IS NOT NULL", userID).
+		Count(&passwords)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if passwords > 0 {
+		return true, nil
+	}
+
+	var passkeys int64
+	result = tx.
+		Model(&model.WebauthnCredential{}).
+		Where("user_id = ?", userID).
+		Count(&passkeys)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if passkeys > 0 {
+		return true, nil
+	}
+
+	var identities int64
+	result = tx.
+		Model(&model.ExternalIdentity{}).
+		Where("user_id = ? AND id <> ?", userID, identityID).
+		Count(&identities)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return identities > 0, nil
+}
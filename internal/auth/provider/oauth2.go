@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+var ErrMissingSubject = errors.New("missing subject in user info")
+
+// Fields of the user info holding the identity
+type ClaimNames struct {
+	Subject       string `json:"subject"`
+	Email         string `json:"email"`
+	EmailVerified string `json:"email_verified"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+	Locale        string `json:"locale"`
+	Zoneinfo      string `json:"zoneinfo"`
+}
+
+// Uses the claims of OpenID Connect for the fields which are not set
+func (c ClaimNames) withDefaults() ClaimNames {
+	defaults := ClaimNames{
+		Subject:       "sub",
+		Email:         "email",
+		EmailVerified: "email_verified",
+		Name:          "name",
+		Picture:       "picture",
+		Locale:        "locale",
+		Zoneinfo:      "zoneinfo",
+	}
+
+	if c.Subject != "" {
+		defaults.Subject = c.Subject
+	}
+	if c.Email != "" {
+		defaults.Email = c.Email
+	}
+	if c.EmailVerified != "" {
+		defaults.EmailVerified = c.EmailVerified
+	}
+	if c.Name != "" {
+		defaults.Name = c.Name
+	}
+	if c.Picture != "" {
+		defaults.Picture = c.Picture
+	}
+	if c.Locale != "" {
+		defaults.Locale = c.Locale
+	}
+	if c.Zoneinfo != "" {
+		defaults.Zoneinfo = c.Zoneinfo
+	}
+
+	return defaults
+}
+
+// Provider without OpenID Connect, whose users are identified by its user info endpoint
+type oauth2Provider struct {
+	config     Config
+	oauth2     oauth2.Config
+	claims     ClaimNames
+	httpClient *http.Client
+}
+
+func newOAuth2Provider(config Config) *oauth2Provider {
+	return &oauth2Provider{
+		config: config,
+		oauth2: config.oauth2Config(oauth2.Endpoint{
+			AuthURL:  config.AuthURL,
+			TokenURL: config.TokenURL,
+		}),
+		claims:     config.Claims.withDefaults(),
+		httpClient: &http.Client{Timeout: time.Second * 10},
+	}
+}
+
+func (p *oauth2Provider) Name() string {
+	return p.config.Name
+}
+
+func (p *oauth2Provider) AuthCodeURL(ctx context.Context, req AuthRequest) (string, error) {
+	return p.oauth2.AuthCodeURL(req.State, oauth2.S256ChallengeOption(req.CodeVerifier)), nil
+}
+
+func (p *oauth2Provider) Exchange(ctx context.Context, code string, req AuthRequest) (Identity, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+	tok, err := p.oauth2.Exchange(ctx, code, oauth2.VerifierOption(req.CodeVerifier))
+	if err != nil {
+		return Identity{}, err
+	}
+
+	userinfoReq, err := http.NewRequestWithContext(ctx, http.MethodGet, p.config.UserinfoURL, nil)
+	if err != nil {
+		return Identity{}, err
+	}
+	userinfoReq.Header.Set("Accept", "application/json")
+
+	resp, err := p.oauth2.Client(ctx, tok).Do(userinfoReq)
+	if err != nil {
+		return Identity{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Identity{}, fmt.Errorf("unexpected status %d from user info of provider %q", resp.StatusCode, p.config.Name)
+	}
+
+	var userinfo map[string]any
+	decoder := json.NewDecoder(resp.Body)
+	// Keeps numeric IDs as they are, rather than as floats
+	decoder.UseNumber()
+	err = decoder.Decode(&userinfo)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	return p.identity(userinfo)
+}
+
+func (p *oauth2Provider) identity(userinfo map[string]any) (Identity, error) {
+	identity := Identity{
+		Subject:    stringClaim(userinfo, p.claims.Subject),
+		Email:      stringClaim(userinfo, p.claims.Email),
+		Name:       stringClaim(userinfo, p.claims.Name),
+		PictureUrl: stringClaim(userinfo, p.claims.Picture),
+		Locale:     stringClaim(userinfo, p.claims.Locale),
+		Zoneinfo:   stringClaim(userinfo, p.claims.Zoneinfo),
+	}
+	if identity.Subject == "" {
+		return Identity{}, ErrMissingSubject
+	}
+
+	if p.config.TrustEmail {
+		identity.EmailVerified = identity.Email != ""
+	} else {
+		var verified flexibleBool
+		if raw, err := json.Marshal(userinfo[p.claims.EmailVerified]); err == nil {
+			_ = verified.UnmarshalJSON(raw)
+		}
+		identity.EmailVerified = bool(verified)
+	}
+
+	return identity, nil
+}
+
+// Gets a claim as a string, whether it is a string or a number
+func stringClaim(userinfo map[string]any, name string) string {
+	switch value := userinfo[name].(type) {
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	default:
+		return ""
+	}
+}
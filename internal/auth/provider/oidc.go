@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"golang.org/x/oauth2"
+)
+
+const (
+	idTokenLeeway = time.Minute
+	// Keys are refetched when a token is signed with an unknown key, at most this often
+	jwksRefreshInterval = time.Minute
+)
+
+var (
+	ErrInvalidIDToken = errors.New("invalid id token")
+	ErrMissingIDToken = errors.New("missing id token")
+)
+
+var idTokenAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// Metadata of an OpenID Connect provider, at "/.well-known/openid-configuration" of the issuer
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+type idTokenClaims struct {
+	Nonce         string       `json:"nonce"`
+	Email         string       `json:"email"`
+	EmailVerified flexibleBool `json:"email_verified"`
+	Name          string       `json:"name"`
+	Picture       string       `json:"picture"`
+	Locale        string       `json:"locale"`
+	Zoneinfo      string       `json:"zoneinfo"`
+}
+
+// Bool which some providers serialize as a string
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "true", `"true"`:
+		*b = true
+	case "false", `"false"`, "null":
+		*b = false
+	default:
+		return fmt.Errorf("invalid bool: %s", data)
+	}
+
+	return nil
+}
+
+// Provider whose endpoints are discovered from its issuer, and whose users are identified by ID tokens
+type oidcProvider struct {
+	config     Config
+	httpClient *http.Client
+
+	mu sync.Mutex
+	// Loaded on first use, and retried on the next use if it failed
+	discovery     *oidcDiscovery
+	keys          jose.JSONWebKeySet
+	keysFetchedAt time.Time
+}
+
+func newOIDCProvider(config Config) *oidcProvider {
+	return &oidcProvider{
+		config:     config,
+		httpClient: &http.Client{Timeout: time.Second * 10},
+	}
+}
+
+func (p *oidcProvider) Name() string {
+	return p.config.Name
+}
+
+func (p *oidcProvider) AuthCodeURL(ctx context.Context, req AuthRequest) (string, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	config := p.oauth2Config(discovery)
+	return config.AuthCodeURL(
+		req.State,
+		oauth2.S256ChallengeOption(req.CodeVerifier),
+		oauth2.SetAuthURLParam("nonce", req.Nonce),
+	), nil
+}
+
+func (p *oidcProvider) Exchange(ctx context.Context, code string, req AuthRequest) (Identity, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+	config := p.oauth2Config(discovery)
+	tok, err := config.Exchange(ctx, code, oauth2.VerifierOption(req.CodeVerifier))
+	if err != nil {
+		return Identity{}, err
+	}
+
+	rawIDToken, ok := tok.Extra("id_token").(string)
+	if !ok {
+		return Identity{}, ErrMissingIDToken
+	}
+
+	return p.verifyIDToken(ctx, discovery, rawIDToken, req.Nonce, time.Now())
+}
+
+func (p *oidcProvider) oauth2Config(discovery *oidcDiscovery) oauth2.Config {
+	return p.config.oauth2Config(oauth2.Endpoint{
+		AuthURL:  discovery.AuthorizationEndpoint,
+		TokenURL: discovery.TokenEndpoint,
+	})
+}
+
+// Verifies the signature and claims of an ID token, then gets the identity it holds
+func (p *oidcProvider) verifyIDToken(
+	ctx context.Context,
+	discovery *oidcDiscovery,
+	rawIDToken string,
+	nonce string,
+	now time.Time,
+) (Identity, error) {
+	parsed, err := jwt.ParseSigned(rawIDToken, idTokenAlgorithms)
+	if err != nil || len(parsed.Headers) != 1 {
+		return Identity{}, ErrInvalidIDToken
+	}
+
+	key, err := p.verificationKey(ctx, discovery, parsed.Headers[0].KeyID, now)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	var claims jwt.Claims
+	var idClaims idTokenClaims
+	err = parsed.Claims(key, &claims, &idClaims)
+	if err != nil {
+		return Identity{}, ErrInvalidIDToken
+	}
+
+	err = claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:      discovery.Issuer,
+		AnyAudience: jwt.Audience{p.config.ClientID},
+		Time:        now,
+	}, idTokenLeeway)
+	if err != nil || claims.Subject == "" || idClaims.Nonce != nonce {
+		return Identity{}, ErrInvalidIDToken
+	}
+
+	return Identity{
+		Subject:       claims.Subject,
+		Email:         idClaims.Email,
+		EmailVerified: bool(idClaims.EmailVerified),
+		Name:          idClaims.Name,
+		PictureUrl:    idClaims.Picture,
+		Locale:        idClaims.Locale,
+		Zoneinfo:      idClaims.Zoneinfo,
+	}, nil
+}
+
+func (p *oidcProvider) discover(ctx context.Context) (*oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	var discovery oidcDiscovery
+	issuer := strings.TrimSuffix(p.config.Issuer, "/")
+	err := p.getJSON(ctx, issuer+"/.well-known/openid-configuration", &discovery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover provider %q: %w", p.config.Name, err)
+	}
+
+	if strings.TrimSuffix(discovery.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("provider %q discovered a mismatched issuer %q", p.config.Name, discovery.Issuer)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JwksURI == "" {
+		return nil, fmt.Errorf("provider %q discovered incomplete metadata", p.config.Name)
+	}
+
+	p.discovery = &discovery
+	return p.discovery, nil
+}
+
+// Gets the key "kid" of the provider, refetching the keys if it is unknown since providers rotate them
+func (p *oidcProvider) verificationKey(
+	ctx context.Context,
+	discovery *oidcDiscovery,
+	kid string,
+	now time.Time,
+) (jose.JSONWebKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if keys := p.keys.Key(kid); len(keys) > 0 {
+		return keys[0], nil
+	}
+
+	if now.Sub(p.keysFetchedAt) >= jwksRefreshInterval {
+		var keys jose.JSONWebKeySet
+		err := p.getJSON(ctx, discovery.JwksURI, &keys)
+		if err != nil {
+			return jose.JSONWebKey{}, fmt.Errorf("failed to fetch keys of provider %q: %w", p.config.Name, err)
+		}
+
+		p.keys = keys
+		p.keysFetchedAt = now
+	}
+
+	if keys := p.keys.Key(kid); len(keys) > 0 {
+		return keys[0], nil
+	}
+
+	return jose.JSONWebKey{}, ErrInvalidIDToken
+}
+
+func (p *oidcProvider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+type testIssuer struct {
+	server *httptest.Server
+	key    *ecdsa.PrivateKey
+	kid    string
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer := &testIssuer{key: key, kid: "k1"}
+	issuer.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			json.NewEncoder(w).Encode(oidcDiscovery{
+				Issuer:                issuer.server.URL,
+				AuthorizationEndpoint: issuer.server.URL + "/authorize",
+				TokenEndpoint:         issuer.server.URL + "/token",
+				JwksURI:               issuer.server.URL + "/jwks",
+			})
+		case "/jwks":
+			json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+				{Key: issuer.key.Public(), KeyID: issuer.kid, Algorithm: string(jose.ES256)},
+			}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(issuer.server.Close)
+
+	return issuer
+}
+
+func (i *testIssuer) sign(t *testing.T, claims jwt.Claims, idClaims map[string]any) string {
+	t.Helper()
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: jose.JSONWebKey{Key: i.key, KeyID: i.kid}},
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := jwt.Signed(signer).Claims(claims).Claims(idClaims).Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return raw
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	issuer := newTestIssuer(t)
+	p := newOIDCProvider(Config{Name: "test", ClientID: "client", Issuer: issuer.server.URL})
+	ctx := context.Background()
+	now := time.Now()
+
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		t.Fatalf("discover() error = %v", err)
+	}
+
+	claims := jwt.Claims{
+		Issuer:   issuer.server.URL,
+		Subject:  "123",
+		Audience: jwt.Audience{"client"},
+		Expiry:   jwt.NewNumericDate(now.Add(time.Minute)),
+	}
+	idClaims := map[string]any{"nonce": "n", "email": "a@example.com", "email_verified": "true"}
+
+	identity, err := p.verifyIDToken(ctx, discovery, issuer.sign(t, claims, idClaims), "n", now)
+	if err != nil {
+		t.Fatalf("verifyIDToken() error = %v", err)
+	}
+	if identity.Subject != "123" || identity.Email != "a@example.com" || !identity.EmailVerified {
+		t.Errorf("verifyIDToken() = %+v", identity)
+	}
+
+	if _, err := p.verifyIDToken(ctx, discovery, issuer.sign(t, claims, idClaims), "other", now); !errors.Is(err, ErrInvalidIDToken) {
+		t.Errorf("verifyIDToken() with another nonce error = %v, want ErrInvalidIDToken", err)
+	}
+
+	otherAudience := claims
+	otherAudience.Audience = jwt.Audience{"other-client"}
+	if _, err := p.verifyIDToken(ctx, discovery, issuer.sign(t, otherAudience, idClaims), "n", now); !errors.Is(err, ErrInvalidIDToken) {
+		t.Errorf("verifyIDToken() for another client error = %v, want ErrInvalidIDToken", err)
+	}
+
+	expired := claims
+	expired.Expiry = jwt.NewNumericDate(now.Add(-time.Hour))
+	if _, err := p.verifyIDToken(ctx, discovery, issuer.sign(t, expired, idClaims), "n", now); !errors.Is(err, ErrInvalidIDToken) {
+		t.Errorf("verifyIDToken() of an expired token error = %v, want ErrInvalidIDToken", err)
+	}
+
+	// Tokens signed by another key with the same ID are rejected
+	forger := newTestIssuer(t)
+	if _, err := p.verifyIDToken(ctx, discovery, forger.sign(t, claims, idClaims), "n", now); !errors.Is(err, ErrInvalidIDToken) {
+		t.Errorf("verifyIDToken() of a forged token error = %v, want ErrInvalidIDToken", err)
+	}
+}
+
+func TestDiscoverMismatchedIssuer(t *testing.T) {
+	issuer := newTestIssuer(t)
+	// Same server under another name, so the issuer of the discovery document differs
+	otherName := strings.Replace(issuer.server.URL, "127.0.0.1", "localhost", 1)
+	p := newOIDCProvider(Config{Name: "test", ClientID: "client", Issuer: otherName})
+
+	if _, err := p.discover(context.Background()); err == nil {
+		t.Errorf("discover() accepted a mismatched issuer")
+	}
+}
+
+func TestOAuth2Identity(t *testing.T) {
+	var userinfo map[string]any
+	decoder := json.NewDecoder(strings.NewReader(`{"id": 583231, "email": "a@example.com", "login": "octocat"}`))
+	decoder.UseNumber()
+	if err := decoder.Decode(&userinfo); err != nil {
+		t.Fatal(err)
+	}
+
+	p := newOAuth2Provider(Config{Name: "github", Claims: ClaimNames{Subject: "id", Name: "login"}})
+	identity, err := p.identity(userinfo)
+	if err != nil {
+		t.Fatalf("identity() error = %v", err)
+	}
+	if identity.Subject != "583231" || identity.Name != "octocat" || identity.EmailVerified {
+		t.Errorf("identity() = %+v, want an unverified email", identity)
+	}
+
+	p.config.TrustEmail = true
+	if identity, _ := p.identity(userinfo); !identity.EmailVerified {
+		t.Errorf("identity() with trusted email = %+v, want a verified email", identity)
+	}
+}
@@ -0,0 +1,240 @@
+// Logins with external identity providers, through OAuth2 or OpenID Connect.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"study-planner-api/internal/auth/token"
+	"study-planner-api/internal/utils"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/oauth2"
+)
+
+// Identity of a user at a provider
+type Identity struct {
+	// Identifies the user at the provider, never reassigned unlike the email
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	PictureUrl    string
+	Locale        string
+	// IANA time zone
+	Zoneinfo string
+}
+
+// Parameters of an authorization request, kept in the state token until the callback
+type AuthRequest struct {
+	State string
+	// PKCE verifier, only its challenge is sent with the authorization request
+	CodeVerifier string
+	Nonce        string
+}
+
+type Provider interface {
+	Name() string
+	// Gets the URL of the consent page of the provider, to redirect the user to
+	AuthCodeURL(ctx context.Context, req AuthRequest) (string, error)
+	// Exchanges the code given to the callback for the identity of the user
+	Exchange(ctx context.Context, code string, req AuthRequest) (Identity, error)
+}
+
+const (
+	typeOIDC   = "oidc"
+	typeOAuth2 = "oauth2"
+
+	nonceLength   = 16
+	bindingLength = 32
+)
+
+// Configuration of a provider, as found in the AUTH_PROVIDERS env var
+type Config struct {
+	// Used in the routes of the provider, e.g. "/auth/{name}/authorize"
+	Name string `json:"name"`
+	// "oidc", the default, or "oauth2" for providers without OpenID Connect such as GitHub
+	Type         string   `json:"type"`
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret"`
+	Scopes       []string `json:"scopes"`
+
+	// OpenID Connect issuer, from which the endpoints are discovered
+	Issuer string `json:"issuer"`
+
+	// Endpoints of OAuth2 providers
+	AuthURL     string `json:"auth_url"`
+	TokenURL    string `json:"token_url"`
+	UserinfoURL string `json:"userinfo_url"`
+	// Fields of the user info of OAuth2 providers, when they differ from the OpenID Connect claims
+	Claims ClaimNames `json:"claims"`
+	// Whether an OAuth2 provider only gives verified emails, when it has no field telling it
+	TrustEmail bool `json:"trust_email"`
+}
+
+func (c Config) oauth2Config(endpoint oauth2.Endpoint) oauth2.Config {
+	return oauth2.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		Endpoint:     endpoint,
+		RedirectURL:  callbackUrl(c.Name),
+		Scopes:       c.Scopes,
+	}
+}
+
+func callbackUrl(name string) string {
+	return fmt.Sprintf("%s/auth/%s/callback", utils.ServerHost(), name)
+}
+
+var providerNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+func New(config Config) (Provider, error) {
+	if !providerNamePattern.MatchString(config.Name) {
+		return nil, fmt.Errorf("invalid provider name: %q", config.Name)
+	}
+	if config.ClientID == "" {
+		return nil, fmt.Errorf("missing client_id of provider %q", config.Name)
+	}
+
+	switch config.Type {
+	case "", typeOIDC:
+		if config.Issuer == "" {
+			return nil, fmt.Errorf("missing issuer of provider %q", config.Name)
+		}
+		return newOIDCProvider(config), nil
+	case typeOAuth2:
+		if config.AuthURL == "" || config.TokenURL == "" || config.UserinfoURL == "" {
+			return nil, fmt.Errorf("missing endpoints of provider %q", config.Name)
+		}
+		return newOAuth2Provider(config), nil
+	default:
+		return nil, fmt.Errorf("unknown type %q of provider %q", config.Type, config.Name)
+	}
+}
+
+// Google is configured by its own env vars, for compatibility with deployments predating AUTH_PROVIDERS
+func googleConfig() (Config, bool) {
+	clientID := os.Getenv("GOOGLE_CLIENT_ID")
+	if clientID == "" {
+		return Config{}, false
+	}
+
+	return Config{
+		Name:         "google",
+		Type:         typeOIDC,
+		ClientID:     clientID,
+		ClientSecret: os.Getenv("GOOGLE_CLIENT_SECRET"),
+		Scopes:       []string{"openid", "email", "profile"},
+		Issuer:       "https://accounts.google.com",
+	}, true
+}
+
+var (
+	providers     map[string]Provider
+	providersOnce sync.Once
+)
+
+// Gets the providers configured by the AUTH_PROVIDERS env var, loaded on first use
+func loadProviders() map[string]Provider {
+	providersOnce.Do(func() {
+		var configs []Config
+		if raw := os.Getenv("AUTH_PROVIDERS"); raw != "" {
+			err := json.Unmarshal([]byte(raw), &configs)
+			if err != nil {
+				panic(fmt.Errorf("invalid AUTH_PROVIDERS: %w", err))
+			}
+		}
+
+		providers = make(map[string]Provider, len(configs)+1)
+		for _, config := range configs {
+			if _, ok := providers[config.Name]; ok {
+				panic(fmt.Errorf("duplicate provider %q", config.Name))
+			}
+
+			provider, err := New(config)
+			if err != nil {
+				panic(err)
+			}
+			providers[config.Name] = provider
+		}
+
+		if config, ok := googleConfig(); ok && providers["google"] == nil {
+			providers["google"], _ = New(config)
+		}
+
+		log.Info().Int("count", len(providers)).Msg("loaded auth providers")
+	})
+
+	return providers
+}
+
+var ErrUnknownProvider = errors.New("unknown provider")
+
+func Get(name string) (Provider, error) {
+	provider, ok := loadProviders()[name]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+
+	return provider, nil
+}
+
+// Starts a login with the provider, or the linking of an identity when "state" has a user to link it to.
+// Returns the URL to redirect the user to, and the binding to keep in a cookie of the browser,
+// which must be given back to the callback along with the state, see token.StateToken.IsBoundTo.
+func Authorize(ctx context.Context, provider Provider, state token.StateToken) (string, string, error) {
+	nonce, err := token.GenerateRandomToken(nonceLength)
+	if err != nil {
+		return "", "", err
+	}
+
+	binding, err := token.GenerateRandomToken(bindingLength)
+	if err != nil {
+		return "", "", err
+	}
+
+	state.AuthProvider = provider.Name()
+	state.CodeVerifier = oauth2.GenerateVerifier()
+	state.Nonce = nonce
+	state.BindingHash = token.HashToken(binding)
+
+	stateToken, err := token.CreateOauth2StateToken(state)
+	if err != nil {
+		return "", "", err
+	}
+
+	authUrl, err := provider.AuthCodeURL(ctx, AuthRequest{
+		State:        stateToken.Value,
+		CodeVerifier: state.CodeVerifier,
+		Nonce:        state.Nonce,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return authUrl, binding, nil
+}
+
+// Completes the authorization with the provider, from the parameters given to the callback.
+// Returns the identity of the user, and the state the authorization was started with.
+func Callback(ctx context.Context, provider Provider, code, state string) (Identity, token.StateToken, error) {
+	stateToken, err := token.ValidateOauth2StateToken(state, provider.Name())
+	if err != nil {
+		return Identity{}, token.StateToken{}, err
+	}
+
+	identity, err := provider.Exchange(ctx, code, AuthRequest{
+		State:        state,
+		CodeVerifier: stateToken.CodeVerifier,
+		Nonce:        stateToken.Nonce,
+	})
+	if err != nil {
+		return Identity{}, token.StateToken{}, err
+	}
+
+	return identity, stateToken, nil
+}
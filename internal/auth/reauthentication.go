@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"errors"
+	"study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"time"
+)
+
+// How recently users without a password must have logged in to confirm a sensitive change
+const reauthenticationMaxAge = time.Minute * 10
+
+var (
+	ErrReauthenticationRequired = errors.New("user must log in again")
+)
+
+// Confirms a sensitive change of the account, such as its deletion or the change of its email,
+// so that a stolen access token is not enough to make it.
+// The user must confirm with their password, or have logged in to the session "sessionID" recently
+// when the account has no password.
+// Failed passwords are throttled as logins are, see VerifyLoginInfo.
+func reauthenticate(user model.User, sessionID string, password *string, client Client) error {
+	if user.Password != nil && user.Email != nil {
+		if password == nil {
+			return ErrIncorrectPassword
+		}
+
+		_, err := VerifyLoginInfo(LoginInfo{Email: *user.Email, Password: *password}, client)
+		return err
+	}
+
+	var session model.UserSession
+	result := database.Instance().
+		Where("user_id = ? AND family_id = ?", user.ID, sessionID).
+		Limit(1).
+		Find(&session)
+	if result.Error != nil {
+		return result.Error
+	}
+	if session.CreatedAt == nil || time.Since(*session.CreatedAt) > reauthenticationMaxAge {
+		return ErrReauthenticationRequired
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"errors"
+	"study-planner-api/internal/auth/role"
+	"study-planner-api/internal/auth/token"
+	db "study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/utils/cache"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// A revoked access token can still be accepted for this duration by instances which cached its state
+const (
+	tokenStateCacheTTL  = time.Second * 30
+	tokenStateCacheSize = 10000
+)
+
+var (
+	ErrTokenRevoked = errors.New("token revoked")
+
+	tokenStateCache = cache.NewTTL[tokenStateKey, tokenState](tokenStateCacheTTL, tokenStateCacheSize)
+)
+
+type tokenStateKey struct {
+	userID    int32
+	sessionID string
+}
+
+type tokenState struct {
+	TokenVersion  int32
+	SessionActive bool
+	Role          string
+}
+
+// Checks that the session of the access token has not been removed,
+// that the token version of the user has not been bumped since the token was issued
+// and that the user still has the role of the token.
+func VerifyAccessToken(info token.AuthInfo) error {
+	// Only users who are not activated get tokens without a session
+	if info.SessionID == "" && info.IsActivated {
+		return ErrTokenRevoked
+	}
+
+	key := tokenStateKey{userID: info.UserID, sessionID: info.SessionID}
+
+	state, ok := tokenStateCache.Get(key)
+	if !ok {
+		var err error
+		state, err = getTokenState(key)
+		if err != nil {
+			return err
+		}
+
+		tokenStateCache.Set(key, state)
+	}
+
+	if state.TokenVersion != info.TokenVersion {
+		return ErrTokenRevoked
+	}
+	if info.SessionID != "" && !state.SessionActive {
+		return ErrTokenRevoked
+	}
+	if role.Parse(state.Role) != role.Parse(info.Role) {
+		return ErrTokenRevoked
+	}
+
+	return nil
+}
+
+func getTokenState(key tokenStateKey) (tokenState, error) {
+	var state tokenState
+	result := db.Instance().
+		Model(&model.User{}).
+		Select(
+			`user.token_version,
+			user.role,
+			EXISTS (
+				SELECT 1 FROM user_session
+				WHERE user_session.user_id = user.id AND user_session.family_id = ?
+					AND (user_session.expires_at IS NULL OR user_session.expires_at > ?)
+			) AS session_active`,
+			key.sessionID, time.Now(),
+		).
+		Where("user.id = ?", key.userID).
+		Take(&state)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return tokenState{}, ErrTokenRevoked
+		}
+		return tokenState{}, result.Error
+	}
+
+	return state, nil
+}
+
+// Drops the cached token states of the user, after some of its tokens have been revoked
+func forgetTokenStates(userID int32) {
+	tokenStateCache.DeleteFunc(func(key tokenStateKey) bool {
+		return key.userID == userID
+	})
+}
+
+// Revokes every access and refresh token of the user,
+// by bumping its token version and removing all of its sessions.
+func RevokeAllTokens(userID int32) error {
+	err := db.Instance().Transaction(func(tx *gorm.DB) error {
+		return revokeAllTokens(tx, userID)
+	})
+	if err != nil {
+		return err
+	}
+
+	forgetTokenStates(userID)
+	log.Info().Int32("user_id", userID).Msg("all tokens of user revoked")
+
+	return nil
+}
+
+func revokeAllTokens(tx *gorm.DB, userID int32) error {
+	result := tx.
+		Model(&model.User{}).
+		Where("id = ?", userID).
+		Update("token_version", gorm.Expr("token_version + 1"))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrUserNotFound
+	}
+
+	return tx.
+		Where("user_id = ?", userID).
+		Delete(&model.UserSession{}).
+		Error
+}
@@ -0,0 +1,42 @@
+// Roles of users, which grant the security scopes of the API to their access tokens.
+package role
+
+import "slices"
+
+type Role string
+
+const (
+	User  Role = "user"
+	Admin Role = "admin"
+)
+
+// Scopes granted by every role, admins can do everything users can
+var scopesOfRole = map[Role][]string{
+	User:  {string(User)},
+	Admin: {string(User), string(Admin)},
+}
+
+// Gets the role from its name, unknown names and tokens issued before roles existed are users
+func Parse(name string) Role {
+	if _, ok := scopesOfRole[Role(name)]; !ok {
+		return User
+	}
+	return Role(name)
+}
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// Whether the role grants every one of the scopes
+func (r Role) Allows(scopes []string) bool {
+	granted := scopesOfRole[Parse(string(r))]
+
+	for _, scope := range scopes {
+		if !slices.Contains(granted, scope) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,28 @@
+package role
+
+import "testing"
+
+func TestAllows(t *testing.T) {
+	tests := []struct {
+		role   Role
+		scopes []string
+		want   bool
+	}{
+		{User, nil, true},
+		{User, []string{"user"}, true},
+		{User, []string{"admin"}, false},
+		{Admin, []string{"admin"}, true},
+		{Admin, []string{"user", "admin"}, true},
+		{Admin, []string{"superuser"}, false},
+		// Tokens issued before roles existed have none
+		{"", []string{"user"}, true},
+		{"", []string{"admin"}, false},
+		{"root", []string{"admin"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.role.Allows(tt.scopes); got != tt.want {
+			t.Errorf("Role(%q).Allows(%v) = %v, want %v", tt.role, tt.scopes, got, tt.want)
+		}
+	}
+}
@@ -2,10 +2,12 @@ package auth
 
 import (
 	"errors"
+	"sync"
 
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 
+	"study-planner-api/internal/audit"
 	db "study-planner-api/internal/database"
 	"study-planner-api/internal/model"
 )
@@ -19,26 +21,128 @@ var (
 	ErrUserNotFound      = errors.New("user not found")
 	ErrIncorrectPassword = errors.New("incorrect password")
 	ErrUserHasNoPassword = errors.New("user has no password")
+	ErrAccountDisabled   = errors.New("account disabled")
 )
 
-func VerifyLoginInfo(info LoginInfo) (model.User, error) {
+// Why a login failed, recorded in its audit event
+type loginFailureReason string
 
+const (
+	loginFailureUnknownEmail      loginFailureReason = "unknown_email"
+	loginFailureNoPassword        loginFailureReason = "no_password"
+	loginFailureIncorrectPassword loginFailureReason = "incorrect_password"
+	loginFailureInvalidMfaCode    loginFailureReason = "invalid_mfa_code"
+	loginFailureInvalidPasskey    loginFailureReason = "invalid_passkey"
+	loginFailureAccountDisabled   loginFailureReason = "account_disabled"
+)
+
+// Records the failed login, of an unknown user when "userID" is nil
+func recordLoginFailed(userID *int32, reason loginFailureReason, client Client) error {
+	return recordLoginFailedTx(db.Instance().DB, userID, reason, client)
+}
+
+func recordLoginFailedTx(tx *gorm.DB, userID *int32, reason loginFailureReason, client Client) error {
+	return audit.RecordTx(tx, audit.Event{
+		UserID:  userID,
+		Type:    audit.EventLoginFailed,
+		Details: map[string]any{"reason": reason},
+		Client:  &client,
+	})
+}
+
+var (
+	dummyPasswordHash     []byte
+	dummyPasswordHashOnce sync.Once
+)
+
+// Gets the hash compared against when the user has no password,
+// so that the response time does not reveal whether the account exists
+func getDummyPasswordHash() []byte {
+	dummyPasswordHashOnce.Do(func() {
+		var err error
+		dummyPasswordHash, err = bcrypt.GenerateFromPassword([]byte("dummy password"), bcrypt.DefaultCost)
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	return dummyPasswordHash
+}
+
+// Verifies the email and password of a login from the client.
+// Failed logins are tracked per account and per IP address, and further attempts are delayed
+// with exponential backoff, then locked out for a while, see TooManyAttemptsError.
+// Deactivated users are refused with ErrAccountDisabled.
+func VerifyLoginInfo(info LoginInfo, client Client) (model.User, error) {
+	attempt, err := reserveLoginAttempt(info.Email, client.IPAddress)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	user, err := verifyLoginInfo(info)
+	if err != nil {
+		var reason loginFailureReason
+		switch {
+		case errors.Is(err, ErrUserNotFound):
+			reason = loginFailureUnknownEmail
+		case errors.Is(err, ErrUserHasNoPassword):
+			reason = loginFailureNoPassword
+		case errors.Is(err, ErrIncorrectPassword):
+			reason = loginFailureIncorrectPassword
+		default:
+			return model.User{}, err
+		}
+
+		var knownUser *model.User
+		if user.ID != 0 {
+			knownUser = &user
+		}
+
+		recordErr := recordLoginFailure(knownUser, attempt, reason, client)
+		if recordErr != nil {
+			return model.User{}, recordErr
+		}
+
+		return model.User{}, err
+	}
+
+	err = releaseLoginAttempt(attempt)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	// Only told once the password is verified, so that it does not reveal the account
+	if user.DisabledAt != nil {
+		err = recordLoginFailed(&user.ID, loginFailureAccountDisabled, client)
+		if err != nil {
+			return model.User{}, err
+		}
+		return model.User{}, ErrAccountDisabled
+	}
+
+	return user, nil
+}
+
+// Returns the user along with the error when it exists but the password does not match
+func verifyLoginInfo(info LoginInfo) (model.User, error) {
 	var user model.User
 	result := db.Instance().Where("email = ?", info.Email).First(&user)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			bcrypt.CompareHashAndPassword(getDummyPasswordHash(), []byte(info.Password))
 			return model.User{}, ErrUserNotFound
 		}
 		return model.User{}, result.Error
 	}
 
 	if user.Password == nil {
-		return model.User{}, ErrUserHasNoPassword
+		bcrypt.CompareHashAndPassword(getDummyPasswordHash(), []byte(info.Password))
+		return user, ErrUserHasNoPassword
 	}
 
 	err := bcrypt.CompareHashAndPassword([]byte(*user.Password), []byte(info.Password))
 	if err != nil {
-		return model.User{}, ErrIncorrectPassword
+		return user, ErrIncorrectPassword
 	}
 
 	return user, nil
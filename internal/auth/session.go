@@ -2,22 +2,179 @@ package auth
 
 import (
 	"errors"
+	"study-planner-api/internal/audit"
 	"study-planner-api/internal/auth/token"
 	db "study-planner-api/internal/database"
 	"study-planner-api/internal/model"
+	"study-planner-api/internal/utils"
+	"study-planner-api/internal/utils/useragent"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
 )
 
 var (
 	ErrMaliciousRefreshToken = errors.New("malicious refresh token")
+	ErrRefreshTokenReused    = errors.New("refresh token reused, token family revoked")
+	ErrSessionNotFound       = errors.New("session not found")
 )
 
-func CreateSession(userID int32, refreshToken token.JwtToken) error {
+// Where a session is used from, recorded along with the audit events of its requests
+type Client = audit.Client
+
+// How the user logged in, recorded in the audit events of the logins
+type LoginMethod string
+
+const (
+	LoginPassword  LoginMethod = "password"
+	LoginMfa       LoginMethod = "mfa"
+	LoginPasskey   LoginMethod = "passkey"
+	LoginMagicLink LoginMethod = "magic_link"
+	LoginProvider  LoginMethod = "provider"
+	// Users are logged in once registered
+	LoginRegistration LoginMethod = "registration"
+)
+
+// Records the login of the user, done by StartSession
+// and to be done when users who are not activated get an access token without a session
+func RecordLogin(userID int32, method LoginMethod, client Client) error {
+	return recordLoginTx(db.Instance().DB, userID, method, client)
+}
+
+func recordLoginTx(tx *gorm.DB, userID int32, method LoginMethod, client Client) error {
+	return audit.RecordTx(tx, audit.Event{
+		UserID:  &userID,
+		Type:    audit.EventLoginSucceeded,
+		Details: map[string]any{"method": method},
+		Client:  &client,
+	})
+}
+
+// Gets the claims of the tokens of the user, from its current state.
+// Deactivated users get no tokens.
+func authInfoOfUser(tx *gorm.DB, userID int32) (token.AuthInfo, error) {
+	var user model.User
+	result := tx.
+		Model(&model.User{}).
+		Where("id = ?", userID).
+		First(&user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return token.AuthInfo{}, ErrUserNotFound
+		}
+		return token.AuthInfo{}, result.Error
+	}
+
+	if user.DisabledAt != nil {
+		return token.AuthInfo{}, ErrAccountDisabled
+	}
+
+	return token.AuthInfo{
+		UserID:       user.ID,
+		IsActivated:  user.IsActivated,
+		TokenVersion: user.TokenVersion,
+		Role:         user.Role,
+	}, nil
+}
+
+// Starts a session of the user with a new token family.
+// Returns the access token bound to the session and its first refresh token.
+// Logging in cancels the scheduled deletion of the account, along with the creation of the session,
+// so that it is only cancelled when the session is issued.
+func StartSession(userID int32, method LoginMethod, client Client) (accessToken token.JwtToken, refreshToken token.JwtToken, err error) {
+	familyID, err := token.NewTokenFamilyID()
+	if err != nil {
+		return token.JwtToken{}, token.JwtToken{}, err
+	}
+
+	err = db.Instance().Transaction(func(tx *gorm.DB) error {
+		authInfo, err := authInfoOfUser(tx, userID)
+		if err != nil {
+			return err
+		}
+
+		authInfo.SessionID = familyID
+		refreshInfo := token.RefreshInfo{
+			AuthInfo: authInfo,
+			FamilyID: familyID,
+		}
+
+		accessToken, refreshToken, err = token.CreateAuthTokens(refreshInfo)
+		if err != nil {
+			return err
+		}
+
+		err = cancelAccountDeletion(tx, userID, client)
+		if err != nil {
+			return err
+		}
+
+		err = createSessionTx(tx, refreshInfo, refreshToken, client)
+		if err != nil {
+			return err
+		}
+
+		return recordLoginTx(tx, userID, method, client)
+	})
+	if err != nil {
+		return token.JwtToken{}, token.JwtToken{}, err
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+// Rotates the refresh token of its session and issues a new access token.
+// The user is reloaded, so that the new tokens reflect its current activation, token version and role.
+func RefreshSession(refreshTokenVal string, client Client) (accessToken token.JwtToken, refreshToken token.JwtToken, err error) {
+	info, _, err := token.ValidateRefreshToken(refreshTokenVal)
+	if err != nil {
+		return token.JwtToken{}, token.JwtToken{}, ErrInvalidToken
+	}
+
+	authInfo, err := authInfoOfUser(db.Instance().DB, info.UserID)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) || errors.Is(err, ErrAccountDisabled) {
+			return token.JwtToken{}, token.JwtToken{}, ErrInvalidToken
+		}
+		return token.JwtToken{}, token.JwtToken{}, err
+	}
+
+	authInfo.SessionID = info.FamilyID
+	info.AuthInfo = authInfo
+
+	accessToken, refreshToken, err = token.CreateAuthTokens(info)
+	if err != nil {
+		return token.JwtToken{}, token.JwtToken{}, err
+	}
+
+	err = UpdateSession(info, refreshTokenVal, refreshToken, client)
+	if err != nil {
+		return token.JwtToken{}, token.JwtToken{}, err
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+// Creates the session of a new token family, holding its first refresh token.
+// Only the hash of the refresh token is stored.
+func CreateSession(info token.RefreshInfo, refreshToken token.JwtToken, client Client) error {
+	return createSessionTx(db.Instance().DB, info, refreshToken, client)
+}
+
+func createSessionTx(tx *gorm.DB, info token.RefreshInfo, refreshToken token.JwtToken, client Client) error {
+	curTime := time.Now()
 	expirationTime := refreshToken.Expiry.Time() // ok to be nill
 
-	result := db.Instance().Create(&model.UserSession{
-		UserID:       userID,
-		RefreshToken: string(refreshToken.Value),
-		ExpiresAt:    &expirationTime,
+	result := tx.Create(&model.UserSession{
+		UserID:           info.UserID,
+		FamilyID:         info.FamilyID,
+		RefreshTokenHash: token.HashToken(refreshToken.Value),
+		ExpiresAt:        &expirationTime,
+		DeviceName:       utils.Ptr(useragent.DeviceName(client.UserAgent)),
+		UserAgent:        &client.UserAgent,
+		IPAddress:        &client.IPAddress,
+		LastUsedAt:       &curTime,
 	})
 	if result.Error != nil {
 		return result.Error
@@ -26,53 +183,229 @@ func CreateSession(userID int32, refreshToken token.JwtToken) error {
 	return nil
 }
 
-func UpdateSession(userID int32, oldRefreshTokenVal string, newRefreshToken token.JwtToken) error {
+// Gets the session of the token family
+func getSession(info token.RefreshInfo) (model.UserSession, error) {
+	var session model.UserSession
+	result := db.Instance().
+		Model(&model.UserSession{}).
+		Where("user_id = ? AND family_id = ?", info.UserID, info.FamilyID).
+		First(&session)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return model.UserSession{}, ErrMaliciousRefreshToken
+		}
+		return model.UserSession{}, result.Error
+	}
+
+	return session, nil
+}
+
+// Replaces the refresh token of the session of the family with the rotated one.
+// If the old token is signed but not the latest of its family, it has already been rotated
+// and is being reused, so the whole family is revoked and ErrRefreshTokenReused is returned.
+// The client is recorded as the last one which used the session.
+func UpdateSession(info token.RefreshInfo, oldRefreshTokenVal string, newRefreshToken token.JwtToken, client Client) error {
+	session, err := getSession(info)
+	if err != nil {
+		return err
+	}
+
+	if !token.VerifyHash(oldRefreshTokenVal, session.RefreshTokenHash) {
+		return revokeReusedFamily(info, client)
+	}
+
+	curTime := time.Now()
 	expirationTime := newRefreshToken.Expiry.Time() // ok to be nill
 
+	// The hash is checked again, in case the token has been rotated concurrently
 	result := db.Instance().
 		Model(&model.UserSession{}).
-		Where("user_id = ? AND refresh_token = ?", userID, oldRefreshTokenVal).
+		Where("id = ? AND refresh_token_hash = ?", session.ID, session.RefreshTokenHash).
 		Updates(&model.UserSession{
-			RefreshToken: newRefreshToken.Value,
-			ExpiresAt:    &expirationTime,
-		}).
-		Limit(1)
+			RefreshTokenHash: token.HashToken(newRefreshToken.Value),
+			ExpiresAt:        &expirationTime,
+			DeviceName:       utils.Ptr(useragent.DeviceName(client.UserAgent)),
+			UserAgent:        &client.UserAgent,
+			IPAddress:        &client.IPAddress,
+			LastUsedAt:       &curTime,
+		})
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
+		return revokeReusedFamily(info, client)
+	}
+
+	return audit.Record(audit.Event{
+		UserID:  &info.UserID,
+		Type:    audit.EventRefreshTokenRotated,
+		Details: map[string]any{"session_id": session.ID},
+		Client:  &client,
+	})
+}
+
+func revokeReusedFamily(info token.RefreshInfo, client Client) error {
+	revoked := false
+
+	err := db.Instance().Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Where("user_id = ? AND family_id = ?", info.UserID, info.FamilyID).
+			Delete(&model.UserSession{})
+		if result.Error != nil {
+			return result.Error
+		}
+		// The family was already revoked or logged out
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		revoked = true
+		return audit.RecordTx(tx, audit.Event{
+			UserID:  &info.UserID,
+			Type:    audit.EventRefreshTokenReuse,
+			Details: map[string]any{"family_id": info.FamilyID},
+			Client:  &client,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if !revoked {
+		return ErrMaliciousRefreshToken
+	}
+	forgetTokenStates(info.UserID)
+
+	log.Warn().
+		Int32("user_id", info.UserID).
+		Str("family_id", info.FamilyID).
+		Msg("refresh token reused, token family revoked")
+
+	return ErrRefreshTokenReused
+}
+
+func VerifySession(info token.RefreshInfo, refreshToken token.JwtToken) error {
+	session, err := getSession(info)
+	if err != nil {
+		return err
+	}
+
+	if !token.VerifyHash(refreshToken.Value, session.RefreshTokenHash) {
 		return ErrMaliciousRefreshToken
 	}
 
 	return nil
 }
 
-func VerifySession(userID int32, refreshToken token.JwtToken) error {
-	var session model.UserSession
-	result := db.Instance().
-		Model(&model.UserSession{}).
-		Where("user_id = ? AND refresh_token = ?", userID, refreshToken.Value).
-		First(&session)
-	if result.Error != nil {
-		return result.Error
+// Logs out of the session of the refresh token
+func RemoveSession(info token.RefreshInfo, refreshTokenVal string, client Client) error {
+	session, err := getSession(info)
+	if err != nil {
+		return err
 	}
-	if result.RowsAffected == 0 {
+
+	if !token.VerifyHash(refreshTokenVal, session.RefreshTokenHash) {
 		return ErrMaliciousRefreshToken
 	}
 
+	return removeSessions(info.UserID, client, map[string]any{"session_id": session.ID}, func(tx *gorm.DB) error {
+		result := tx.
+			Where("id = ?", session.ID).
+			Delete(&model.UserSession{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrMaliciousRefreshToken
+		}
+		return nil
+	})
+}
+
+// Deletes sessions of the user with "remove" and records the logout with the details, in one transaction
+func removeSessions(
+	userID int32,
+	client Client,
+	details map[string]any,
+	remove func(tx *gorm.DB) error,
+) error {
+	err := db.Instance().Transaction(func(tx *gorm.DB) error {
+		err := remove(tx)
+		if err != nil {
+			return err
+		}
+
+		return audit.RecordTx(tx, audit.Event{
+			UserID:  &userID,
+			Type:    audit.EventLogout,
+			Details: details,
+			Client:  &client,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	forgetTokenStates(userID)
 	return nil
 }
 
-func RemoveSession(userID int32, refreshTokenVal string) error {
+// Gets the unexpired sessions of the user, most recently used first
+func GetSessions(userID int32) ([]model.UserSession, error) {
+	var sessions []model.UserSession
 	result := db.Instance().
-		Where("user_id = ? AND refresh_token = ?", userID, refreshTokenVal).
-		Delete(&model.UserSession{})
+		Model(&model.UserSession{}).
+		Where("user_id = ? AND (expires_at IS NULL OR expires_at > ?)", userID, time.Now()).
+		Order("last_used_at DESC").
+		Find(&sessions)
 	if result.Error != nil {
-		return result.Error
+		return nil, result.Error
 	}
-	if result.RowsAffected == 0 {
-		return ErrMaliciousRefreshToken
+
+	return sessions, nil
+}
+
+// Revokes the session, its refresh token can no longer be used
+func RemoveSessionOfUser(sessionID int32, userID int32, client Client) error {
+	return removeSessions(userID, client, map[string]any{"session_id": sessionID}, func(tx *gorm.DB) error {
+		result := tx.
+			Where("id = ? AND user_id = ?", sessionID, userID).
+			Delete(&model.UserSession{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrSessionNotFound
+		}
+		return nil
+	})
+}
+
+// Revokes every session of the user except the one of the refresh token.
+// Returns the number of revoked sessions.
+func RemoveOtherSessions(info token.RefreshInfo, refreshTokenVal string, client Client) (int64, error) {
+	err := VerifySession(info, token.JwtToken{Value: refreshTokenVal})
+	if err != nil {
+		return 0, err
 	}
 
-	return nil
+	var removed int64
+	details := make(map[string]any)
+	err = removeSessions(info.UserID, client, details, func(tx *gorm.DB) error {
+		result := tx.
+			Where("user_id = ? AND family_id <> ?", info.UserID, info.FamilyID).
+			Delete(&model.UserSession{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		removed = result.RowsAffected
+		details["other_sessions"] = removed
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return removed, nil
 }
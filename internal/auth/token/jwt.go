@@ -1,6 +1,8 @@
 package token
 
 import (
+	"time"
+
 	"github.com/go-jose/go-jose/v4"
 	"github.com/go-jose/go-jose/v4/jwt"
 )
@@ -13,20 +15,31 @@ type JwtToken struct {
 
 // TODO: Wrap this to abstract from the library
 type JwtRegisteredClaims = jwt.Claims
+type JwtExpectedClaims = jwt.Expected
 type JwtPrivateClaims = interface{}
 
-// Signs a JWT token in JWS format
+// Signs a JWT token in JWS format, with the current signing key of the keyring
 func SignJwtToken(
-	key []byte,
+	keyring *Keyring,
 	rc JwtRegisteredClaims,
 	pcs ...JwtPrivateClaims,
 ) (JwtToken, error) {
+	key, err := keyring.signingKey(time.Now())
+	if err != nil {
+		return JwtToken{}, err
+	}
+
 	signer, err := jose.NewSigner(
 		jose.SigningKey{
-			Algorithm: jose.HS256,
-			Key:       key,
+			Algorithm: key.algorithm,
+			// The key ID is added to the "kid" header
+			Key: jose.JSONWebKey{
+				Key:       key.privateKey,
+				KeyID:     key.id,
+				Algorithm: string(key.algorithm),
+			},
 		},
-		nil,
+		(&jose.SignerOptions{}).WithType("JWT"),
 	)
 	if err != nil {
 		return JwtToken{}, err
@@ -46,39 +59,53 @@ func SignJwtToken(
 	return JwtToken{Value: signedToken, JwtRegisteredClaims: rc}, nil
 }
 
-// Validates a signed JWT token in JWS format.
+// Validates a signed JWT token in JWS format, with the key of the keyring matching its "kid" header.
+// The registered claims must match "expected", the time claims are validated with some leeway.
 // "claims" will be populated and used for validation.
 // Returns the registered claims.
 func ValidateSignedJwtToken(
-	key []byte,
+	keyring *Keyring,
 	token string,
+	expected JwtExpectedClaims,
 	claims ...JwtPrivateClaims,
 ) (JwtRegisteredClaims, error) {
 	// Parse the token
 	parsed, err := jwt.ParseSigned(
 		token,
-		[]jose.SignatureAlgorithm{jose.HS256},
+		[]jose.SignatureAlgorithm{jose.EdDSA, jose.RS256},
 	)
 	if err != nil {
 		return JwtRegisteredClaims{}, err
 	}
+	if len(parsed.Headers) != 1 {
+		return JwtRegisteredClaims{}, ErrUnknownSigningKey
+	}
+
+	key, err := keyring.verificationKey(parsed.Headers[0].KeyID, time.Now())
+	if err != nil {
+		return JwtRegisteredClaims{}, err
+	}
+	if parsed.Headers[0].Algorithm != string(key.algorithm) {
+		return JwtRegisteredClaims{}, ErrUnknownSigningKey
+	}
+	publicKey := key.privateKey.Public()
 
 	var rc JwtRegisteredClaims
 
 	// Parse the registered claims
-	err = parsed.Claims(key, &rc)
+	err = parsed.Claims(publicKey, &rc)
 	if err != nil {
 		return JwtRegisteredClaims{}, err
 	}
 
 	// Validate the token
-	err = rc.Validate(jwt.Expected{})
+	err = rc.ValidateWithLeeway(expected, jwtLeeway)
 	if err != nil {
 		return JwtRegisteredClaims{}, err
 	}
 
 	// Parse the private claims
-	err = parsed.Claims(key, claims...)
+	err = parsed.Claims(publicKey, claims...)
 	if err != nil {
 		return JwtRegisteredClaims{}, err
 	}
@@ -120,11 +147,13 @@ func EncryptJwtToken(
 }
 
 // Validates an encrypted JWT token in JWE format.
+// The registered claims must match "expected", the time claims are validated with some leeway.
 // "claims" will be populated and used for validation.
 // Returns the registered claims.
 func ValidateEncryptedJwtToken(
 	key []byte,
 	token string,
+	expected JwtExpectedClaims,
 	claims ...JwtPrivateClaims,
 ) (JwtRegisteredClaims, error) {
 	// Decrypt & parse the token
@@ -145,7 +174,7 @@ func ValidateEncryptedJwtToken(
 	}
 
 	// Validate the token
-	err = rc.Validate(jwt.Expected{})
+	err = rc.ValidateWithLeeway(expected, jwtLeeway)
 	if err != nil {
 		return JwtRegisteredClaims{}, err
 	}
@@ -0,0 +1,216 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrNoSigningKey      = errors.New("no active signing key")
+	ErrUnknownSigningKey = errors.New("unknown signing key")
+)
+
+// Configuration of a signing key, as found in the JWT_KEYS env var
+type jwtKeyConfig struct {
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	// PEM encoded PKCS #8 private key, Ed25519 for EdDSA or RSA for RS256
+	PrivateKey string `json:"private_key"`
+	// When the key starts being used to sign tokens, it is only published before
+	ActiveFrom *time.Time `json:"active_from,omitempty"`
+	// When the tokens signed with the key stop being accepted
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+type jwtKey struct {
+	id         string
+	algorithm  jose.SignatureAlgorithm
+	privateKey crypto.Signer
+	activeFrom time.Time
+	expiresAt  *time.Time
+}
+
+func (k jwtKey) expired(now time.Time) bool {
+	return k.expiresAt != nil && !now.Before(*k.expiresAt)
+}
+
+// Keys used to sign and verify JWTs.
+// Tokens are signed with the most recently activated key, and verified with any unexpired key
+// matching their "kid" header. To rotate keys, add a new key with "active_from" in the future,
+// so that it is published before it is used, then expire the old key once the tokens
+// it signed have expired.
+type Keyring struct {
+	// Sorted by activation time, most recent first
+	keys []jwtKey
+}
+
+func NewKeyring(config []byte) (*Keyring, error) {
+	var configs []jwtKeyConfig
+	err := json.Unmarshal(config, &configs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keyring config: %w", err)
+	}
+
+	keys := make([]jwtKey, len(configs))
+	ids := make(map[string]bool, len(configs))
+	for i, c := range configs {
+		if c.KeyID == "" || ids[c.KeyID] {
+			return nil, fmt.Errorf("missing or duplicate kid: %q", c.KeyID)
+		}
+		ids[c.KeyID] = true
+
+		key, err := parseJwtKey(c)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", c.KeyID, err)
+		}
+		keys[i] = key
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].activeFrom.After(keys[j].activeFrom)
+	})
+
+	return &Keyring{keys: keys}, nil
+}
+
+func parseJwtKey(c jwtKeyConfig) (jwtKey, error) {
+	block, _ := pem.Decode([]byte(c.PrivateKey))
+	if block == nil {
+		return jwtKey{}, errors.New("private key is not PEM encoded")
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return jwtKey{}, err
+	}
+
+	key := jwtKey{
+		id:        c.KeyID,
+		algorithm: jose.SignatureAlgorithm(c.Algorithm),
+		expiresAt: c.ExpiresAt,
+	}
+	if c.ActiveFrom != nil {
+		key.activeFrom = *c.ActiveFrom
+	}
+
+	switch privateKey := parsed.(type) {
+	case ed25519.PrivateKey:
+		if key.algorithm != jose.EdDSA {
+			return jwtKey{}, fmt.Errorf("algorithm %s cannot be used with an Ed25519 key", c.Algorithm)
+		}
+		key.privateKey = privateKey
+	case *rsa.PrivateKey:
+		if key.algorithm != jose.RS256 {
+			return jwtKey{}, fmt.Errorf("algorithm %s cannot be used with an RSA key", c.Algorithm)
+		}
+		key.privateKey = privateKey
+	default:
+		return jwtKey{}, errors.New("only Ed25519 and RSA keys are supported")
+	}
+
+	return key, nil
+}
+
+// Creates a keyring with a single Ed25519 key, which only lives as long as the process
+func newEphemeralKeyring() (*Keyring, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := GenerateRandomToken(8)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Keyring{keys: []jwtKey{{
+		id:         "ephemeral-" + id,
+		algorithm:  jose.EdDSA,
+		privateKey: privateKey,
+	}}}, nil
+}
+
+// Gets the key to sign new tokens with
+func (k *Keyring) signingKey(now time.Time) (jwtKey, error) {
+	for _, key := range k.keys {
+		if !key.activeFrom.After(now) && !key.expired(now) {
+			return key, nil
+		}
+	}
+
+	return jwtKey{}, ErrNoSigningKey
+}
+
+// Gets the key to verify a token signed with the key "kid"
+func (k *Keyring) verificationKey(kid string, now time.Time) (jwtKey, error) {
+	for _, key := range k.keys {
+		if key.id == kid && !key.expired(now) {
+			return key, nil
+		}
+	}
+
+	return jwtKey{}, ErrUnknownSigningKey
+}
+
+// Gets the public keys of the unexpired keys, including the ones which are not active yet
+func (k *Keyring) PublicKeys(now time.Time) jose.JSONWebKeySet {
+	set := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{}}
+	for _, key := range k.keys {
+		if key.expired(now) {
+			continue
+		}
+
+		set.Keys = append(set.Keys, jose.JSONWebKey{
+			Key:       key.privateKey.Public(),
+			KeyID:     key.id,
+			Algorithm: string(key.algorithm),
+			Use:       "sig",
+		})
+	}
+
+	return set
+}
+
+var (
+	jwtKeyring     *Keyring
+	jwtKeyringOnce sync.Once
+)
+
+// Gets the keyring configured by the JWT_KEYS env var, loaded on first use.
+// Without configuration, an ephemeral key is generated when running locally.
+func GetKeyring() *Keyring {
+	jwtKeyringOnce.Do(func() {
+		var err error
+
+		config := os.Getenv("JWT_KEYS")
+		switch {
+		case config != "":
+			jwtKeyring, err = NewKeyring([]byte(config))
+		case os.Getenv("APP_ENV") == "local":
+			log.Warn().Msg("JWT_KEYS is not set, tokens are signed with an ephemeral key")
+			jwtKeyring, err = newEphemeralKeyring()
+		default:
+			err = errors.New("JWT_KEYS is not set")
+		}
+
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	return jwtKeyring
+}
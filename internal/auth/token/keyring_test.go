@@ -0,0 +1,131 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+)
+
+func encodePrivateKey(t *testing.T, key crypto.Signer) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func newTestKeyring(t *testing.T, configs []jwtKeyConfig) *Keyring {
+	t.Helper()
+
+	config, err := json.Marshal(configs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyring, err := NewKeyring(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return keyring
+}
+
+func TestKeyringRotation(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyring := newTestKeyring(t, []jwtKeyConfig{
+		{KeyID: "old", Algorithm: "EdDSA", PrivateKey: encodePrivateKey(t, edKey), ExpiresAt: &past},
+		{KeyID: "current", Algorithm: "RS256", PrivateKey: encodePrivateKey(t, rsaKey), ActiveFrom: &past},
+		{KeyID: "next", Algorithm: "EdDSA", PrivateKey: encodePrivateKey(t, edKey), ActiveFrom: &future},
+	})
+
+	key, err := keyring.signingKey(now)
+	if err != nil || key.id != "current" {
+		t.Errorf("signingKey(now) = %q, %v, want current", key.id, err)
+	}
+
+	key, err = keyring.signingKey(future.Add(time.Second))
+	if err != nil || key.id != "next" {
+		t.Errorf("signingKey(future) = %q, %v, want next", key.id, err)
+	}
+
+	if _, err := keyring.verificationKey("old", now); !errors.Is(err, ErrUnknownSigningKey) {
+		t.Errorf("verificationKey(old) error = %v, want ErrUnknownSigningKey", err)
+	}
+
+	jwks := keyring.PublicKeys(now)
+	if len(jwks.Keys) != 2 || len(jwks.Key("next")) != 1 || len(jwks.Key("old")) != 0 {
+		t.Errorf("PublicKeys() = %+v, want the current and next keys", jwks.Keys)
+	}
+}
+
+func TestSignAndValidate(t *testing.T) {
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	keyring := newTestKeyring(t, []jwtKeyConfig{
+		{KeyID: "a", Algorithm: "EdDSA", PrivateKey: encodePrivateKey(t, edKey)},
+	})
+
+	token, err := SignJwtToken(keyring, JwtRegisteredClaims{Subject: "1"}, AuthInfo{UserID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var info AuthInfo
+	rc, err := ValidateSignedJwtToken(keyring, token.Value, JwtExpectedClaims{}, &info)
+	if err != nil {
+		t.Fatalf("ValidateSignedJwtToken() error = %v", err)
+	}
+	if rc.Subject != "1" || info.UserID != 1 {
+		t.Errorf("ValidateSignedJwtToken() = %+v, %+v", rc, info)
+	}
+
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+	otherKeyring := newTestKeyring(t, []jwtKeyConfig{
+		{KeyID: "a", Algorithm: "EdDSA", PrivateKey: encodePrivateKey(t, otherKey)},
+	})
+	if _, err := ValidateSignedJwtToken(otherKeyring, token.Value, JwtExpectedClaims{}, &info); err == nil {
+		t.Errorf("ValidateSignedJwtToken() accepted a token signed with another key")
+	}
+}
+
+func TestNewKeyringInvalid(t *testing.T) {
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	pemKey := encodePrivateKey(t, edKey)
+
+	tests := []struct {
+		name    string
+		configs []jwtKeyConfig
+	}{
+		{name: "missing kid", configs: []jwtKeyConfig{{Algorithm: "EdDSA", PrivateKey: pemKey}}},
+		{name: "duplicate kid", configs: []jwtKeyConfig{
+			{KeyID: "a", Algorithm: "EdDSA", PrivateKey: pemKey},
+			{KeyID: "a", Algorithm: "EdDSA", PrivateKey: pemKey},
+		}},
+		{name: "mismatched algorithm", configs: []jwtKeyConfig{{KeyID: "a", Algorithm: "RS256", PrivateKey: pemKey}}},
+		{name: "not PEM", configs: []jwtKeyConfig{{KeyID: "a", Algorithm: "EdDSA", PrivateKey: "secret"}}},
+	}
+
+	for _, tt := range tests {
+		config, _ := json.Marshal(tt.configs)
+		if _, err := NewKeyring(config); err == nil {
+			t.Errorf("NewKeyring() with %s did not fail", tt.name)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package token
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+// Kind of a JWT. Every kind has its own "typ" claim and audience,
+// so that a token issued for one purpose is never accepted for another.
+type tokenKind string
+
+const (
+	accessTokenKind      tokenKind = "access"
+	refreshTokenKind     tokenKind = "refresh"
+	oauth2StateTokenKind tokenKind = "oauth2_state"
+	mfaChallengeKind     tokenKind = "mfa_challenge"
+	passkeyRegisterKind  tokenKind = "passkey_register"
+	passkeyLoginKind     tokenKind = "passkey_login"
+)
+
+// Clock skew tolerated when validating the time claims
+const jwtLeeway = time.Second * 30
+
+var (
+	ErrWrongTokenKind = errors.New("wrong token kind")
+)
+
+type typeClaim struct {
+	Type tokenKind `json:"typ"`
+}
+
+func jwtIssuer() string {
+	if issuer := os.Getenv("JWT_ISSUER"); issuer != "" {
+		return issuer
+	}
+
+	return "study-planner-api"
+}
+
+func (k tokenKind) audience() string {
+	return jwtIssuer() + "/" + string(k)
+}
+
+// Registered claims of a new token of this kind, expiring after "duration"
+func (k tokenKind) claims(duration time.Duration) JwtRegisteredClaims {
+	curTime := time.Now()
+
+	return JwtRegisteredClaims{
+		Issuer:   jwtIssuer(),
+		Audience: jwt.Audience{k.audience()},
+		IssuedAt: jwt.NewNumericDate(curTime),
+		Expiry:   jwt.NewNumericDate(curTime.Add(duration)),
+	}
+}
+
+// Registered claims expected from a token of this kind
+func (k tokenKind) expected() JwtExpectedClaims {
+	return JwtExpectedClaims{
+		Issuer:      jwtIssuer(),
+		AnyAudience: jwt.Audience{k.audience()},
+	}
+}
+
+func (k tokenKind) checkType(claim typeClaim) error {
+	if claim.Type != k {
+		return ErrWrongTokenKind
+	}
+
+	return nil
+}
+
+// Signs a token of this kind with the keyring
+func (k tokenKind) sign(rc JwtRegisteredClaims, payload JwtPrivateClaims) (JwtToken, error) {
+	return SignJwtToken(GetKeyring(), rc, typeClaim{Type: k}, payload)
+}
+
+// Validates a signed token of this kind, "payload" will be populated
+func (k tokenKind) validateSigned(token string, payload JwtPrivateClaims) (JwtRegisteredClaims, error) {
+	var claim typeClaim
+	rc, err := ValidateSignedJwtToken(GetKeyring(), token, k.expected(), &claim, payload)
+	if err != nil {
+		return JwtRegisteredClaims{}, err
+	}
+
+	return rc, k.checkType(claim)
+}
+
+// Encrypts a token of this kind with the encryption key
+func (k tokenKind) encrypt(rc JwtRegisteredClaims, payload JwtPrivateClaims) (JwtToken, error) {
+	return EncryptJwtToken(encryptionKey, rc, typeClaim{Type: k}, payload)
+}
+
+// Validates an encrypted token of this kind, "payload" will be populated
+func (k tokenKind) validateEncrypted(token string, payload JwtPrivateClaims) (JwtRegisteredClaims, error) {
+	var claim typeClaim
+	rc, err := ValidateEncryptedJwtToken(encryptionKey, token, k.expected(), &claim, payload)
+	if err != nil {
+		return JwtRegisteredClaims{}, err
+	}
+
+	return rc, k.checkType(claim)
+}
@@ -0,0 +1,60 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+)
+
+func TestTokenKindConfusion(t *testing.T) {
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	keyring := newTestKeyring(t, []jwtKeyConfig{
+		{KeyID: "a", Algorithm: "EdDSA", PrivateKey: encodePrivateKey(t, edKey)},
+	})
+
+	token, err := SignJwtToken(
+		keyring,
+		refreshTokenKind.claims(time.Minute),
+		typeClaim{Type: refreshTokenKind},
+		RefreshInfo{AuthInfo: AuthInfo{UserID: 1}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var claim typeClaim
+	var info AuthInfo
+	if _, err := ValidateSignedJwtToken(keyring, token.Value, accessTokenKind.expected(), &claim, &info); err == nil {
+		t.Errorf("a refresh token was accepted with the audience of access tokens")
+	}
+
+	if _, err := ValidateSignedJwtToken(keyring, token.Value, refreshTokenKind.expected(), &claim, &info); err != nil {
+		t.Fatalf("ValidateSignedJwtToken() error = %v", err)
+	}
+	if err := accessTokenKind.checkType(claim); err != ErrWrongTokenKind {
+		t.Errorf("checkType() error = %v, want ErrWrongTokenKind", err)
+	}
+}
+
+func TestTokenLeeway(t *testing.T) {
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	keyring := newTestKeyring(t, []jwtKeyConfig{
+		{KeyID: "a", Algorithm: "EdDSA", PrivateKey: encodePrivateKey(t, edKey)},
+	})
+
+	sign := func(duration time.Duration) string {
+		token, err := SignJwtToken(keyring, accessTokenKind.claims(duration), typeClaim{Type: accessTokenKind})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token.Value
+	}
+
+	if _, err := ValidateSignedJwtToken(keyring, sign(-jwtLeeway/2), accessTokenKind.expected()); err != nil {
+		t.Errorf("a token expired within the leeway was rejected: %v", err)
+	}
+	if _, err := ValidateSignedJwtToken(keyring, sign(-jwtLeeway*2), accessTokenKind.expected()); err == nil {
+		t.Errorf("a token expired beyond the leeway was accepted")
+	}
+}
@@ -0,0 +1,22 @@
+package token
+
+import "testing"
+
+func TestEncryptSecret(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+
+	ciphertext, err := encryptSecret(key, "JBSWY3DPEHPK3PXP")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret, err := decryptSecret(key, ciphertext)
+	if err != nil || secret != "JBSWY3DPEHPK3PXP" {
+		t.Errorf("decryptSecret() = %q, %v", secret, err)
+	}
+
+	otherKey := []byte("fedcba9876543210fedcba9876543210")
+	if _, err := decryptSecret(otherKey, ciphertext); err != ErrInvalidCiphertext {
+		t.Errorf("decryptSecret() with another key error = %v, want ErrInvalidCiphertext", err)
+	}
+}
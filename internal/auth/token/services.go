@@ -6,7 +6,6 @@ import (
 	"study-planner-api/internal/model"
 	"time"
 
-	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -35,6 +34,14 @@ var (
 		Duration: time.Hour * 1,
 		alias:    "password_reset",
 	}
+	MagicLogin = TokenPurpose{
+		Duration: time.Minute * 15,
+		alias:    "magic_login",
+	}
+	EmailChange = TokenPurpose{
+		Duration: time.Hour * 1,
+		alias:    "email_change",
+	}
 )
 
 func (tp TokenPurpose) String() string {
@@ -47,12 +54,20 @@ func TokenPurposeFromString(str string) (TokenPurpose, error) {
 		return Activation, nil
 	case PasswordReset.alias:
 		return PasswordReset, nil
+	case MagicLogin.alias:
+		return MagicLogin, nil
+	case EmailChange.alias:
+		return EmailChange, nil
 	default:
 		return *new(TokenPurpose), errors.New("invalid token purpose")
 	}
 }
 
 func CreateToken(userId int32, purpose TokenPurpose) (string, error) {
+	return createToken(database.Instance().DB, userId, purpose)
+}
+
+func createToken(tx *gorm.DB, userId int32, purpose TokenPurpose) (string, error) {
 	curTime := time.Now()
 
 	token, err := GenerateRandomToken(TokenLength)
@@ -63,9 +78,7 @@ func CreateToken(userId int32, purpose TokenPurpose) (string, error) {
 	hash := HashToken(token)
 	expirationTime := curTime.Add(purpose.Duration)
 
-	log.Info().Msgf("Hash: %s", hash)
-
-	result := database.Instance().
+	result := tx.
 		Model(&model.Token{}).
 		Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: "user_id"}, {Name: "purpose"}},
@@ -82,8 +95,6 @@ func CreateToken(userId int32, purpose TokenPurpose) (string, error) {
 		return "", result.Error
 	}
 
-	log.Info().Msgf("Token: %s", token)
-
 	return token, nil
 }
 
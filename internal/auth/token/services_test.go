@@ -0,0 +1,54 @@
+package token
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestCreateTokenLogsNoSecret(t *testing.T) {
+	t.Setenv("SIGNING_KEY", "test signing key")
+
+	db, err := gorm.Open(sqlite.New(sqlite.Config{
+		DriverName: "libsql",
+		DSN:        "file:" + filepath.Join(t.TempDir(), "token.db"),
+	}), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.Exec(`CREATE TABLE token (
+		id INTEGER PRIMARY KEY,
+		user_id INTEGER NOT NULL,
+		token_hash TEXT NOT NULL,
+		purpose TEXT NOT NULL,
+		created_at DATETIME,
+		expires_at DATETIME NOT NULL,
+		UNIQUE (user_id, purpose)
+	)`).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var logs bytes.Buffer
+	logger := log.Logger
+	log.Logger = zerolog.New(&logs).Level(zerolog.TraceLevel)
+	t.Cleanup(func() { log.Logger = logger })
+
+	token, err := createToken(db, 1, PasswordReset)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, secret := range []string{token, HashToken(token)} {
+		if strings.Contains(logs.String(), secret) {
+			t.Errorf("createToken() logged a secret: %s", logs.String())
+		}
+	}
+}
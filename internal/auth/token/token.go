@@ -1,34 +1,46 @@
 package token
 
 import (
-	"crypto/hmac"
 	"crypto/rand"
-	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"os"
+	"study-planner-api/internal/utils/signature"
 	"sync"
 	"time"
-
-	"github.com/go-jose/go-jose/v4/jwt"
 )
 
 type AuthInfo struct {
 	UserID      int32 `json:"user_id"`
 	IsActivated bool  `json:"is_activated"`
+	// Family ID of the session the token was issued for,
+	// empty for tokens of users who are not activated, which have no session
+	SessionID string `json:"sid,omitempty"`
+	// Token version of the user when the token was issued
+	TokenVersion int32 `json:"ver"`
+	// Role of the user when the token was issued, absent from tokens issued before roles existed
+	Role string `json:"role,omitempty"`
 }
 
-type RefreshInfo = AuthInfo
+type RefreshInfo struct {
+	AuthInfo
+	// Identifies the refresh tokens rotated from the same login
+	FamilyID string `json:"fid"`
+}
 
 var (
-	signingKey    = []byte(os.Getenv("SIGNING_KEY"))
 	encryptionKey = []byte(os.Getenv("ENCRYPTION_KEY"))
 )
 
 const (
+	tokenFamilyIDLength = 16
+	tokenIDLength       = 16
+
 	accessTokenDuration      = time.Minute * 15
 	refreshtokenDuration     = time.Hour * 24 * 7
 	oauth2StateTokenDuration = time.Minute * 15
+	mfaChallengeDuration     = time.Minute * 5
+	passkeyChallengeDuration = time.Minute * 5
 )
 
 func GenerateRandomToken(length int) (string, error) {
@@ -42,67 +54,51 @@ func GenerateRandomToken(length int) (string, error) {
 }
 
 func HashToken(token string) string {
-	h := hmac.New(sha256.New, signingKey)
-	h.Write([]byte(token))
-	return hex.EncodeToString(h.Sum(nil))
+	return signature.Sign(token)
 }
 
 func VerifyHash(token, hash string) bool {
-	expectedHash := HashToken(token)
-	return hmac.Equal([]byte(expectedHash), []byte(hash))
+	return signature.Verify(token, hash)
+}
+
+// Generates the ID of a new refresh token family, to be used on login
+func NewTokenFamilyID() (string, error) {
+	return GenerateRandomToken(tokenFamilyIDLength)
 }
 
 // Creates an access token
 func CreateAccessToken(payload AuthInfo) (JwtToken, error) {
-	curTime := time.Now()
-
-	token, err := SignJwtToken(
-		signingKey,
-		JwtRegisteredClaims{
-			IssuedAt: jwt.NewNumericDate(curTime),
-			Expiry:   jwt.NewNumericDate(curTime.Add(accessTokenDuration)),
-		},
-		payload,
-	)
-	if err != nil {
-		return JwtToken{}, err
-	}
-
-	return token, nil
+	return accessTokenKind.sign(accessTokenKind.claims(accessTokenDuration), payload)
 }
 
-// Creates a refresh token
+// Creates a refresh token.
+// Every token has a unique ID, so that a rotated token never equals the previous one.
 func CreateRefreshToken(payload RefreshInfo) (JwtToken, error) {
-	curTime := time.Now()
-
-	token, err := SignJwtToken(
-		signingKey,
-		JwtRegisteredClaims{
-			IssuedAt: jwt.NewNumericDate(curTime),
-			Expiry:   jwt.NewNumericDate(curTime.Add(refreshtokenDuration)),
-		},
-		payload,
-	)
+	id, err := GenerateRandomToken(tokenIDLength)
 	if err != nil {
 		return JwtToken{}, err
 	}
 
-	return token, nil
+	claims := refreshTokenKind.claims(refreshtokenDuration)
+	claims.ID = id
+
+	return refreshTokenKind.sign(claims, payload)
 }
 
-func CreateAuthTokens(authInfo AuthInfo) (accessToken JwtToken, refreshToken JwtToken, err error) {
+// Creates an access token and a refresh token of the family in "refreshInfo"
+func CreateAuthTokens(refreshInfo RefreshInfo) (accessToken JwtToken, refreshToken JwtToken, err error) {
 	var accessErr, refreshErr error
 	var wg sync.WaitGroup
 	wg.Add(2)
 
 	go func() {
 		defer wg.Done()
-		accessToken, accessErr = CreateAccessToken(authInfo)
+		accessToken, accessErr = CreateAccessToken(refreshInfo.AuthInfo)
 	}()
 
 	go func() {
 		defer wg.Done()
-		refreshToken, refreshErr = CreateRefreshToken(authInfo)
+		refreshToken, refreshErr = CreateRefreshToken(refreshInfo)
 	}()
 
 	wg.Wait()
@@ -121,7 +117,7 @@ func CreateAuthTokens(authInfo AuthInfo) (accessToken JwtToken, refreshToken Jwt
 // Returns the auth info and registered claims.
 func ValidateAccessToken(token string) (AuthInfo, JwtRegisteredClaims, error) {
 	var authInfo AuthInfo
-	regClaims, err := ValidateSignedJwtToken(signingKey, token, &authInfo)
+	regClaims, err := accessTokenKind.validateSigned(token, &authInfo)
 	if err != nil {
 		return AuthInfo{}, JwtRegisteredClaims{}, err
 	}
@@ -133,7 +129,7 @@ func ValidateAccessToken(token string) (AuthInfo, JwtRegisteredClaims, error) {
 // Returns the registered claims.
 func ValidateRefreshToken(token string) (RefreshInfo, JwtRegisteredClaims, error) {
 	var refreshInfo RefreshInfo
-	regClaims, err := ValidateSignedJwtToken(signingKey, token, &refreshInfo)
+	regClaims, err := refreshTokenKind.validateSigned(token, &refreshInfo)
 	if err != nil {
 		return RefreshInfo{}, JwtRegisteredClaims{}, err
 	}
@@ -141,34 +137,39 @@ func ValidateRefreshToken(token string) (RefreshInfo, JwtRegisteredClaims, error
 	return refreshInfo, regClaims, nil
 }
 
+// Application which started an authorization, to deliver its result to
 type RequestApplication struct {
 	Host string `json:"request_host,omitempty"`
+	// How the result is delivered: "popup", the default, "redirect" or "deep_link"
+	Mode string `json:"mode,omitempty"`
+	// Origin of the opener of a popup, or URL to redirect to
+	ReturnTo string `json:"return_to,omitempty"`
+	// PKCE challenge of the application, to verify when it redeems its exchange code
+	CodeChallenge string `json:"code_challenge,omitempty"`
 }
 
 type StateToken struct {
 	RequestApplication
 	AuthProvider string `json:"auth_provider"`
+	// PKCE verifier, only its challenge is sent to the provider with the authorization request
+	CodeVerifier string `json:"code_verifier"`
+	// Bound to the ID token by OpenID Connect providers
+	Nonce string `json:"nonce,omitempty"`
+	// User linking the identity to their account, unset for logins
+	LinkUserID int32 `json:"link_user_id,omitempty"`
+	// Hash of the value kept in a cookie by the browser which started the authorization
+	BindingHash string `json:"binding_hash"`
 }
 
-func CreateOauth2StateToken(app RequestApplication, provider string) (JwtToken, error) {
-	curTime := time.Now()
-
-	token, err := EncryptJwtToken(
-		encryptionKey,
-		JwtRegisteredClaims{
-			Expiry:   jwt.NewNumericDate(curTime.Add(oauth2StateTokenDuration)),
-			IssuedAt: jwt.NewNumericDate(curTime),
-		},
-		StateToken{
-			RequestApplication: app,
-			AuthProvider:       provider,
-		},
-	)
-	if err != nil {
-		return JwtToken{}, err
-	}
+// Whether the authorization was started by the browser holding "binding" in its cookie,
+// so that a state cannot be completed by the browser of someone else
+func (s StateToken) IsBoundTo(binding string) bool {
+	return s.BindingHash != "" && VerifyHash(binding, s.BindingHash)
+}
 
-	return token, nil
+// Creates the state of an authorization request, encrypted since it holds the PKCE verifier
+func CreateOauth2StateToken(state StateToken) (JwtToken, error) {
+	return oauth2StateTokenKind.encrypt(oauth2StateTokenKind.claims(oauth2StateTokenDuration), state)
 }
 
 var (
@@ -176,16 +177,86 @@ var (
 	ErrMismatchedProvider = errors.New("mismatched provider")
 )
 
-func ValidateOauth2StateToken(token string, provider string) (RequestApplication, error) {
+func ValidateOauth2StateToken(token string, provider string) (StateToken, error) {
 	var stateToken StateToken
-	_, err := ValidateEncryptedJwtToken(encryptionKey, token, &stateToken)
+	_, err := oauth2StateTokenKind.validateEncrypted(token, &stateToken)
 	if err != nil {
-		return RequestApplication{}, ErrInvalidStateToken
+		return StateToken{}, ErrInvalidStateToken
 	}
 
 	if stateToken.AuthProvider != provider {
-		return RequestApplication{}, ErrMismatchedProvider
+		return StateToken{}, ErrMismatchedProvider
+	}
+
+	return stateToken, nil
+}
+
+// Proves that the password of the user was verified,
+// while the login waits for its second factor
+type MfaChallenge struct {
+	// Unique ID of the challenge, its "jti" claim
+	ID     string `json:"-"`
+	UserID int32  `json:"user_id"`
+}
+
+var ErrInvalidMfaChallenge = errors.New("invalid mfa challenge")
+
+// Creates a short-lived challenge token, exchanged for auth tokens with a second factor.
+// Every challenge has a unique ID, so that it can be tracked until it is completed.
+func CreateMfaChallengeToken(userID int32) (JwtToken, error) {
+	id, err := GenerateRandomToken(tokenIDLength)
+	if err != nil {
+		return JwtToken{}, err
+	}
+
+	claims := mfaChallengeKind.claims(mfaChallengeDuration)
+	claims.ID = id
+
+	return mfaChallengeKind.sign(claims, MfaChallenge{UserID: userID})
+}
+
+func ValidateMfaChallengeToken(token string) (MfaChallenge, error) {
+	var challenge MfaChallenge
+	claims, err := mfaChallengeKind.validateSigned(token, &challenge)
+	if err != nil || claims.ID == "" {
+		return MfaChallenge{}, ErrInvalidMfaChallenge
+	}
+	challenge.ID = claims.ID
+
+	return challenge, nil
+}
+
+// Challenge of a WebAuthn ceremony, given back by the client to complete it
+type PasskeyChallenge struct {
+	Challenge string `json:"challenge"`
+	// User registering a passkey, unset for logins
+	UserID int32 `json:"user_id,omitempty"`
+}
+
+var ErrInvalidPasskeyChallenge = errors.New("invalid passkey challenge")
+
+func CreatePasskeyRegisterToken(challenge PasskeyChallenge) (JwtToken, error) {
+	return passkeyRegisterKind.sign(passkeyRegisterKind.claims(passkeyChallengeDuration), challenge)
+}
+
+func ValidatePasskeyRegisterToken(token string) (PasskeyChallenge, error) {
+	return validatePasskeyChallengeToken(passkeyRegisterKind, token)
+}
+
+func CreatePasskeyLoginToken(challenge PasskeyChallenge) (JwtToken, error) {
+	return passkeyLoginKind.sign(passkeyLoginKind.claims(passkeyChallengeDuration), challenge)
+}
+
+func ValidatePasskeyLoginToken(token string) (PasskeyChallenge, error) {
+	return validatePasskeyChallengeToken(passkeyLoginKind, token)
+}
+
+func validatePasskeyChallengeToken(kind tokenKind, token string) (PasskeyChallenge, error) {
+	var challenge PasskeyChallenge
+	_, err := kind.validateSigned(token, &challenge)
+	if err != nil || challenge.Challenge == "" {
+		return PasskeyChallenge{}, ErrInvalidPasskeyChallenge
 	}
 
-	return stateToken.RequestApplication, nil
+	return challenge, nil
 }
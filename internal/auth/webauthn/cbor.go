@@ -0,0 +1,165 @@
+package webauthn
+
+import (
+	"errors"
+	"math"
+)
+
+var errInvalidCBOR = errors.New("invalid cbor")
+
+// Nesting deeper than attestation objects and COSE keys ever need is rejected
+const cborMaxDepth = 16
+
+// Minimal CBOR decoder (RFC 8949), supporting what attestation objects and COSE keys use:
+// integers, byte and text strings, arrays, maps, booleans and null, all of definite length.
+// Integers are decoded as int64, byte strings as []byte and maps as map[any]any.
+type cborDecoder struct {
+	data  []byte
+	pos   int
+	depth int
+}
+
+// Decodes the first CBOR item of "data".
+// Returns the item and the bytes following it.
+func decodeCBOR(data []byte) (any, []byte, error) {
+	d := cborDecoder{data: data}
+	item, err := d.item()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return item, data[d.pos:], nil
+}
+
+func (d *cborDecoder) remaining() uint64 {
+	return uint64(len(d.data) - d.pos)
+}
+
+// Reads the head of an item, its major type and argument
+func (d *cborDecoder) head() (major byte, info byte, arg uint64, err error) {
+	if d.remaining() < 1 {
+		return 0, 0, 0, errInvalidCBOR
+	}
+
+	b := d.data[d.pos]
+	d.pos++
+	major, info = b>>5, b&0x1f
+
+	switch {
+	case info < 24:
+		return major, info, uint64(info), nil
+	case info <= 27:
+		n := 1 << (info - 24)
+		if d.remaining() < uint64(n) {
+			return 0, 0, 0, errInvalidCBOR
+		}
+
+		for _, b := range d.data[d.pos : d.pos+n] {
+			arg = arg<<8 | uint64(b)
+		}
+		d.pos += n
+
+		return major, info, arg, nil
+	default:
+		// Indefinite lengths and reserved values
+		return 0, 0, 0, errInvalidCBOR
+	}
+}
+
+func (d *cborDecoder) item() (any, error) {
+	d.depth++
+	defer func() { d.depth-- }()
+	if d.depth > cborMaxDepth {
+		return nil, errInvalidCBOR
+	}
+
+	major, info, arg, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case 0:
+		if arg > math.MaxInt64 {
+			return nil, errInvalidCBOR
+		}
+		return int64(arg), nil
+	case 1:
+		if arg > math.MaxInt64 {
+			return nil, errInvalidCBOR
+		}
+		return -1 - int64(arg), nil
+	case 2, 3:
+		if arg > d.remaining() {
+			return nil, errInvalidCBOR
+		}
+
+		value := d.data[d.pos : d.pos+int(arg)]
+		d.pos += int(arg)
+
+		if major == 3 {
+			return string(value), nil
+		}
+		return append([]byte(nil), value...), nil
+	case 4:
+		// Every item takes at least a byte
+		if arg > d.remaining() {
+			return nil, errInvalidCBOR
+		}
+
+		array := make([]any, arg)
+		for i := range array {
+			array[i], err = d.item()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return array, nil
+	case 5:
+		if arg > d.remaining()/2 {
+			return nil, errInvalidCBOR
+		}
+
+		m := make(map[any]any, arg)
+		for range arg {
+			key, err := d.item()
+			if err != nil {
+				return nil, err
+			}
+
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, errInvalidCBOR
+			}
+			if _, ok := m[key]; ok {
+				return nil, errInvalidCBOR
+			}
+
+			m[key], err = d.item()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return m, nil
+	case 7:
+		if info >= 24 {
+			// Floats are never used by WebAuthn
+			return nil, errInvalidCBOR
+		}
+
+		switch arg {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		case 22:
+			return nil, nil
+		}
+	}
+
+	// Tags and other simple values
+	return nil, errInvalidCBOR
+}
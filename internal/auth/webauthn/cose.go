@@ -0,0 +1,132 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+)
+
+// COSE key parameters and algorithms (RFC 9052, RFC 9053)
+const (
+	coseKeyType   = 1
+	coseAlgorithm = 3
+
+	coseCurve   = -1 // EC2 and OKP
+	coseX       = -2 // EC2 and OKP
+	coseY       = -3 // EC2
+	coseModulus = -1 // RSA
+	coseExp     = -2 // RSA
+
+	coseKeyTypeOKP = 1
+	coseKeyTypeEC2 = 2
+	coseKeyTypeRSA = 3
+
+	coseCurveP256    = 1
+	coseCurveEd25519 = 6
+)
+
+// Algorithms accepted for credentials, as COSE algorithm identifiers
+const (
+	AlgES256 = -7
+	AlgEdDSA = -8
+	AlgRS256 = -257
+)
+
+var (
+	ErrUnsupportedKey   = errors.New("unsupported credential public key")
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+type publicKey struct {
+	algorithm int64
+	key       crypto.PublicKey
+}
+
+// Parses a COSE encoded public key
+func parseCOSEKey(data []byte) (publicKey, error) {
+	item, rest, err := decodeCBOR(data)
+	if err != nil || len(rest) != 0 {
+		return publicKey{}, ErrUnsupportedKey
+	}
+
+	m, ok := item.(map[any]any)
+	if !ok {
+		return publicKey{}, ErrUnsupportedKey
+	}
+
+	intParam := func(label int64) int64 {
+		value, _ := m[label].(int64)
+		return value
+	}
+	bytesParam := func(label int64) []byte {
+		value, _ := m[label].([]byte)
+		return value
+	}
+
+	algorithm := intParam(coseAlgorithm)
+
+	switch intParam(coseKeyType) {
+	case coseKeyTypeEC2:
+		x, y := bytesParam(coseX), bytesParam(coseY)
+		if algorithm != AlgES256 || intParam(coseCurve) != coseCurveP256 || len(x) != 32 || len(y) != 32 {
+			break
+		}
+
+		key := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+		if !key.Curve.IsOnCurve(key.X, key.Y) {
+			break
+		}
+
+		return publicKey{algorithm: algorithm, key: key}, nil
+	case coseKeyTypeOKP:
+		x := bytesParam(coseX)
+		if algorithm != AlgEdDSA || intParam(coseCurve) != coseCurveEd25519 || len(x) != ed25519.PublicKeySize {
+			break
+		}
+
+		return publicKey{algorithm: algorithm, key: ed25519.PublicKey(x)}, nil
+	case coseKeyTypeRSA:
+		n, e := bytesParam(coseModulus), bytesParam(coseExp)
+		if algorithm != AlgRS256 || len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			break
+		}
+
+		exp := new(big.Int).SetBytes(e)
+		return publicKey{algorithm: algorithm, key: &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(exp.Int64()),
+		}}, nil
+	}
+
+	return publicKey{}, ErrUnsupportedKey
+}
+
+// Verifies the signature of the message, as produced by an authenticator
+func (k publicKey) verify(message, signature []byte) error {
+	digest := sha256.Sum256(message)
+
+	var ok bool
+	switch key := k.key.(type) {
+	case *ecdsa.PublicKey:
+		ok = ecdsa.VerifyASN1(key, digest[:], signature)
+	case ed25519.PublicKey:
+		ok = ed25519.Verify(key, message, signature)
+	case *rsa.PublicKey:
+		ok = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+	}
+
+	if !ok {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,380 @@
+// WebAuthn relying party (https://www.w3.org/TR/webauthn-2/), for passkey logins.
+// Attestation is not requested, so registrations are trusted
+// the same way as the authenticators they come from, without verifying their maker.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	challengeLength = 32
+	// How long the user has to complete a ceremony
+	CeremonyTimeout = time.Minute * 5
+
+	ceremonyCreate = "webauthn.create"
+	ceremonyGet    = "webauthn.get"
+)
+
+// Flags of the authenticator data
+const (
+	flagUserPresent      = 0x01
+	flagUserVerified     = 0x04
+	flagAttestedCredData = 0x40
+	flagExtensionData    = 0x80
+)
+
+var (
+	ErrInvalidClientData   = errors.New("invalid client data")
+	ErrInvalidAuthData     = errors.New("invalid authenticator data")
+	ErrUserNotVerified     = errors.New("user not verified")
+	ErrSignCountRegression = errors.New("sign count did not increase, the authenticator may be cloned")
+	ErrInvalidAttestation  = errors.New("invalid attestation object")
+)
+
+// Base64url without padding, as used for binary values of the JSON serialization of WebAuthn
+var Encoding = base64.RawURLEncoding
+
+type RelyingParty struct {
+	// Domain the credentials are scoped to
+	ID   string
+	Name string
+	// Origins the ceremonies may be performed from
+	Origins []string
+}
+
+// Generates the challenge of a new ceremony, base64url encoded
+func NewChallenge() (string, error) {
+	challenge := make([]byte, challengeLength)
+	_, err := rand.Read(challenge)
+	if err != nil {
+		return "", err
+	}
+
+	return Encoding.EncodeToString(challenge), nil
+}
+
+type RelyingPartyEntity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type UserEntity struct {
+	// Base64url encoded user handle, returned by the authenticator on login
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type CredentialParameter struct {
+	Type      string `json:"type"`
+	Algorithm int    `json:"alg"`
+}
+
+type CredentialDescriptor struct {
+	Type string `json:"type"`
+	// Base64url encoded credential ID
+	ID string `json:"id"`
+}
+
+type AuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+// Options of navigator.credentials.create(), in their JSON serialization
+type CreationOptions struct {
+	Challenge              string                 `json:"challenge"`
+	RelyingParty           RelyingPartyEntity     `json:"rp"`
+	User                   UserEntity             `json:"user"`
+	CredentialParameters   []CredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection AuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                 `json:"attestation"`
+}
+
+// Options of navigator.credentials.get(), in their JSON serialization
+type RequestOptions struct {
+	Challenge        string `json:"challenge"`
+	Timeout          int64  `json:"timeout"`
+	RelyingPartyID   string `json:"rpId"`
+	UserVerification string `json:"userVerification"`
+}
+
+// Gets the options to register a discoverable credential, a passkey, of the user.
+// "exclude" are the IDs of the credentials the user already registered.
+func (rp RelyingParty) CreationOptions(challenge string, user UserEntity, exclude [][]byte) CreationOptions {
+	excludeCredentials := make([]CredentialDescriptor, len(exclude))
+	for i, id := range exclude {
+		excludeCredentials[i] = CredentialDescriptor{Type: "public-key", ID: Encoding.EncodeToString(id)}
+	}
+
+	return CreationOptions{
+		Challenge:    challenge,
+		RelyingParty: RelyingPartyEntity{ID: rp.ID, Name: rp.Name},
+		User:         user,
+		CredentialParameters: []CredentialParameter{
+			{Type: "public-key", Algorithm: AlgES256},
+			{Type: "public-key", Algorithm: AlgEdDSA},
+			{Type: "public-key", Algorithm: AlgRS256},
+		},
+		Timeout:            CeremonyTimeout.Milliseconds(),
+		ExcludeCredentials: excludeCredentials,
+		AuthenticatorSelection: AuthenticatorSelection{
+			ResidentKey:      "required",
+			UserVerification: "required",
+		},
+		Attestation: "none",
+	}
+}
+
+// Gets the options to log in with a passkey, which is discovered by the authenticator
+func (rp RelyingParty) RequestOptions(challenge string) RequestOptions {
+	return RequestOptions{
+		Challenge:        challenge,
+		Timeout:          CeremonyTimeout.Milliseconds(),
+		RelyingPartyID:   rp.ID,
+		UserVerification: "required",
+	}
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// Verifies the client data of a ceremony of type "ceremony", answering "challenge"
+func (rp RelyingParty) verifyClientData(clientDataJSON []byte, ceremony string, challenge string) error {
+	var data clientData
+	err := json.Unmarshal(clientDataJSON, &data)
+	if err != nil {
+		return ErrInvalidClientData
+	}
+
+	if data.Type != ceremony ||
+		subtle.ConstantTimeCompare([]byte(data.Challenge), []byte(challenge)) != 1 ||
+		!slices.Contains(rp.Origins, data.Origin) {
+		return ErrInvalidClientData
+	}
+
+	return nil
+}
+
+type authenticatorData struct {
+	rpIDHash  []byte
+	flags     byte
+	signCount uint32
+	// Only set by registrations
+	credentialID []byte
+	publicKey    []byte
+}
+
+func parseAuthenticatorData(data []byte) (authenticatorData, error) {
+	if len(data) < 37 {
+		return authenticatorData{}, ErrInvalidAuthData
+	}
+
+	authData := authenticatorData{
+		rpIDHash:  data[:32],
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+	rest := data[37:]
+
+	if authData.flags&flagAttestedCredData != 0 {
+		// AAGUID, then the length of the credential ID
+		if len(rest) < 18 {
+			return authenticatorData{}, ErrInvalidAuthData
+		}
+
+		idLength := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if len(rest) < idLength {
+			return authenticatorData{}, ErrInvalidAuthData
+		}
+		authData.credentialID, rest = rest[:idLength], rest[idLength:]
+
+		_, afterKey, err := decodeCBOR(rest)
+		if err != nil {
+			return authenticatorData{}, ErrInvalidAuthData
+		}
+		authData.publicKey, rest = rest[:len(rest)-len(afterKey)], afterKey
+	}
+
+	// Extension outputs are ignored, as no extension is requested
+	if authData.flags&flagExtensionData == 0 && len(rest) != 0 {
+		return authenticatorData{}, ErrInvalidAuthData
+	}
+
+	return authData, nil
+}
+
+// Verifies the authenticator data was produced for the relying party, with a verified user
+func (rp RelyingParty) verifyAuthenticatorData(authData authenticatorData) error {
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(authData.rpIDHash, rpIDHash[:]) {
+		return ErrInvalidAuthData
+	}
+
+	if authData.flags&flagUserPresent == 0 || authData.flags&flagUserVerified == 0 {
+		return ErrUserNotVerified
+	}
+
+	return nil
+}
+
+// Registered credential
+type Credential struct {
+	ID []byte
+	// COSE encoded
+	PublicKey []byte
+	SignCount uint32
+}
+
+// Response of the authenticator to navigator.credentials.create()
+type AttestationResponse struct {
+	ClientDataJSON    []byte
+	AttestationObject []byte
+}
+
+// Verifies the registration of a credential, answering "challenge".
+// Returns the credential to be stored.
+func (rp RelyingParty) VerifyRegistration(challenge string, response AttestationResponse) (Credential, error) {
+	err := rp.verifyClientData(response.ClientDataJSON, ceremonyCreate, challenge)
+	if err != nil {
+		return Credential{}, err
+	}
+
+	item, rest, err := decodeCBOR(response.AttestationObject)
+	if err != nil || len(rest) != 0 {
+		return Credential{}, ErrInvalidAttestation
+	}
+
+	attestationObject, ok := item.(map[any]any)
+	if !ok {
+		return Credential{}, ErrInvalidAttestation
+	}
+
+	// The attestation statement is not verified, whatever its format, as none was requested
+	rawAuthData, ok := attestationObject["authData"].([]byte)
+	if !ok {
+		return Credential{}, ErrInvalidAttestation
+	}
+
+	authData, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return Credential{}, err
+	}
+
+	err = rp.verifyAuthenticatorData(authData)
+	if err != nil {
+		return Credential{}, err
+	}
+
+	if authData.flags&flagAttestedCredData == 0 {
+		return Credential{}, ErrInvalidAuthData
+	}
+
+	_, err = parseCOSEKey(authData.publicKey)
+	if err != nil {
+		return Credential{}, err
+	}
+
+	return Credential{
+		ID:        authData.credentialID,
+		PublicKey: authData.publicKey,
+		SignCount: authData.signCount,
+	}, nil
+}
+
+// Response of the authenticator to navigator.credentials.get()
+type AssertionResponse struct {
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+	// Handle of the user the credential was created for, returned for discoverable credentials
+	UserHandle []byte
+}
+
+// Verifies a login with the credential, answering "challenge".
+// Returns the new sign count of the credential.
+func (rp RelyingParty) VerifyAssertion(challenge string, credential Credential, response AssertionResponse) (uint32, error) {
+	err := rp.verifyClientData(response.ClientDataJSON, ceremonyGet, challenge)
+	if err != nil {
+		return 0, err
+	}
+
+	authData, err := parseAuthenticatorData(response.AuthenticatorData)
+	if err != nil {
+		return 0, err
+	}
+
+	err = rp.verifyAuthenticatorData(authData)
+	if err != nil {
+		return 0, err
+	}
+
+	key, err := parseCOSEKey(credential.PublicKey)
+	if err != nil {
+		return 0, err
+	}
+
+	clientDataHash := sha256.Sum256(response.ClientDataJSON)
+	signed := append(append([]byte(nil), response.AuthenticatorData...), clientDataHash[:]...)
+	err = key.verify(signed, response.Signature)
+	if err != nil {
+		return 0, err
+	}
+
+	// Authenticators which do not count signatures always send 0
+	if (authData.signCount != 0 || credential.SignCount != 0) && authData.signCount <= credential.SignCount {
+		return 0, ErrSignCountRegression
+	}
+
+	return authData.signCount, nil
+}
+
+var (
+	relyingParty     RelyingParty
+	relyingPartyOnce sync.Once
+)
+
+// Gets the relying party configured by the WEBAUTHN_RP_ID and WEBAUTHN_RP_ORIGINS env vars, loaded on first use.
+// Without configuration, localhost and the origins allowed by CORS are used when running locally.
+func GetRelyingParty() RelyingParty {
+	relyingPartyOnce.Do(func() {
+		relyingParty = RelyingParty{
+			ID:      os.Getenv("WEBAUTHN_RP_ID"),
+			Name:    "Study Planner",
+			Origins: strings.Fields(os.Getenv("WEBAUTHN_RP_ORIGINS")),
+		}
+
+		if relyingParty.ID == "" || len(relyingParty.Origins) == 0 {
+			if os.Getenv("APP_ENV") != "local" {
+				panic("WEBAUTHN_RP_ID and WEBAUTHN_RP_ORIGINS are not set")
+			}
+
+			log.Warn().Msg("WebAuthn relying party is not configured, using localhost")
+			relyingParty.ID = "localhost"
+			relyingParty.Origins = strings.Fields(os.Getenv("ALLOW_ORIGINS"))
+		}
+	})
+
+	return relyingParty
+}
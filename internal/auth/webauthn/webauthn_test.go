@@ -0,0 +1,198 @@
+package webauthn
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// Encodes the CBOR subset used by authenticators, with map keys in insertion order
+func encodeCBOR(item any) []byte {
+	head := func(major byte, arg uint64) []byte {
+		switch {
+		case arg < 24:
+			return []byte{major<<5 | byte(arg)}
+		case arg < 1<<8:
+			return []byte{major<<5 | 24, byte(arg)}
+		default:
+			b := []byte{major<<5 | 25, 0, 0}
+			binary.BigEndian.PutUint16(b[1:], uint16(arg))
+			return b
+		}
+	}
+
+	switch v := item.(type) {
+	case int:
+		if v < 0 {
+			return head(1, uint64(-1-v))
+		}
+		return head(0, uint64(v))
+	case []byte:
+		return append(head(2, uint64(len(v))), v...)
+	case string:
+		return append(head(3, uint64(len(v))), v...)
+	case [][2]any:
+		out := head(5, uint64(len(v)))
+		for _, pair := range v {
+			out = append(out, encodeCBOR(pair[0])...)
+			out = append(out, encodeCBOR(pair[1])...)
+		}
+		return out
+	}
+
+	panic("unsupported item")
+}
+
+type testAuthenticator struct {
+	credentialID []byte
+	coseKey      []byte
+	sign         func(message []byte) []byte
+	signCount    uint32
+}
+
+func newES256Authenticator() testAuthenticator {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	x, y := make([]byte, 32), make([]byte, 32)
+	key.X.FillBytes(x)
+	key.Y.FillBytes(y)
+
+	return testAuthenticator{
+		credentialID: []byte("es256-credential"),
+		coseKey: encodeCBOR([][2]any{
+			{coseKeyType, coseKeyTypeEC2}, {coseAlgorithm, AlgES256},
+			{coseCurve, coseCurveP256}, {coseX, x}, {coseY, y},
+		}),
+		sign: func(message []byte) []byte {
+			digest := sha256.Sum256(message)
+			signature, _ := ecdsa.SignASN1(rand.Reader, key, digest[:])
+			return signature
+		},
+	}
+}
+
+func newEdDSAAuthenticator() testAuthenticator {
+	public, private, _ := ed25519.GenerateKey(rand.Reader)
+
+	return testAuthenticator{
+		credentialID: []byte("eddsa-credential"),
+		coseKey: encodeCBOR([][2]any{
+			{coseKeyType, coseKeyTypeOKP}, {coseAlgorithm, AlgEdDSA},
+			{coseCurve, coseCurveEd25519}, {coseX, []byte(public)},
+		}),
+		sign: func(message []byte) []byte {
+			return ed25519.Sign(private, message)
+		},
+	}
+}
+
+func (a *testAuthenticator) authData(rpID string, flags byte, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append(rpIDHash[:], flags, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(data[33:], a.signCount)
+
+	if attested {
+		data = append(data, make([]byte, 16)...) // AAGUID
+		data = binary.BigEndian.AppendUint16(data, uint16(len(a.credentialID)))
+		data = append(data, a.credentialID...)
+		data = append(data, a.coseKey...)
+	}
+
+	return data
+}
+
+func clientDataJSON(ceremony, challenge, origin string) []byte {
+	data, _ := json.Marshal(clientData{Type: ceremony, Challenge: challenge, Origin: origin})
+	return data
+}
+
+func (a *testAuthenticator) create(rp RelyingParty, challenge string) AttestationResponse {
+	authData := a.authData(rp.ID, flagUserPresent|flagUserVerified|flagAttestedCredData, true)
+
+	return AttestationResponse{
+		ClientDataJSON: clientDataJSON(ceremonyCreate, challenge, rp.Origins[0]),
+		AttestationObject: encodeCBOR([][2]any{
+			{"fmt", "none"}, {"attStmt", [][2]any{}}, {"authData", authData},
+		}),
+	}
+}
+
+func (a *testAuthenticator) get(rp RelyingParty, challenge string, origin string) AssertionResponse {
+	a.signCount++
+
+	authData := a.authData(rp.ID, flagUserPresent|flagUserVerified, false)
+	data := clientDataJSON(ceremonyGet, challenge, origin)
+	clientDataHash := sha256.Sum256(data)
+
+	return AssertionResponse{
+		ClientDataJSON:    data,
+		AuthenticatorData: authData,
+		Signature:         a.sign(append(append([]byte(nil), authData...), clientDataHash[:]...)),
+	}
+}
+
+func TestCeremonies(t *testing.T) {
+	rp := RelyingParty{ID: "example.com", Name: "Example", Origins: []string{"https://app.example.com"}}
+
+	for name, authenticator := range map[string]testAuthenticator{
+		"ES256": newES256Authenticator(),
+		"EdDSA": newEdDSAAuthenticator(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			challenge, _ := NewChallenge()
+			credential, err := rp.VerifyRegistration(challenge, authenticator.create(rp, challenge))
+			if err != nil {
+				t.Fatalf("VerifyRegistration() error = %v", err)
+			}
+			if string(credential.ID) != string(authenticator.credentialID) {
+				t.Errorf("VerifyRegistration() credential ID = %q", credential.ID)
+			}
+
+			if _, err := rp.VerifyRegistration("other", authenticator.create(rp, challenge)); !errors.Is(err, ErrInvalidClientData) {
+				t.Errorf("VerifyRegistration() with another challenge error = %v, want ErrInvalidClientData", err)
+			}
+
+			challenge, _ = NewChallenge()
+			signCount, err := rp.VerifyAssertion(challenge, credential, authenticator.get(rp, challenge, rp.Origins[0]))
+			if err != nil || signCount != 1 {
+				t.Fatalf("VerifyAssertion() = %d, %v, want 1", signCount, err)
+			}
+
+			if _, err := rp.VerifyAssertion(challenge, credential, authenticator.get(rp, challenge, "https://evil.com")); !errors.Is(err, ErrInvalidClientData) {
+				t.Errorf("VerifyAssertion() from another origin error = %v, want ErrInvalidClientData", err)
+			}
+
+			response := authenticator.get(rp, challenge, rp.Origins[0])
+			response.Signature[len(response.Signature)-1] ^= 0xff
+			if _, err := rp.VerifyAssertion(challenge, credential, response); !errors.Is(err, ErrInvalidSignature) {
+				t.Errorf("VerifyAssertion() with a tampered signature error = %v, want ErrInvalidSignature", err)
+			}
+
+			credential.SignCount = authenticator.signCount + 1
+			if _, err := rp.VerifyAssertion(challenge, credential, authenticator.get(rp, challenge, rp.Origins[0])); !errors.Is(err, ErrSignCountRegression) {
+				t.Errorf("VerifyAssertion() with a lower sign count error = %v, want ErrSignCountRegression", err)
+			}
+		})
+	}
+}
+
+func TestDecodeCBORInvalid(t *testing.T) {
+	tests := map[string][]byte{
+		"truncated string":  {0x45, 'a'},
+		"indefinite length": {0x5f},
+		"float":             {0xfa, 0, 0, 0, 0},
+		"duplicate key":     {0xa2, 0x01, 0x01, 0x01, 0x02},
+		"huge array":        {0x9b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	}
+
+	for name, data := range tests {
+		if _, _, err := decodeCBOR(data); err == nil {
+			t.Errorf("decodeCBOR() with %s did not fail", name)
+		}
+	}
+}
@@ -5,7 +5,11 @@ import (
 	"study-planner-api/internal/database"
 	"study-planner-api/internal/model"
 	"study-planner-api/internal/task"
+	"study-planner-api/internal/user/profile"
+	"study-planner-api/internal/utils"
+	"study-planner-api/internal/utils/cursor"
 
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -30,17 +34,49 @@ var (
 	ErrSessionNotFound        = errors.New("session not found")
 	ErrSessionNotActive       = errors.New("session is not active")
 	ErrSessionNotBelongToUser = errors.New("session does not belong to user")
+
+	ErrInvalidCursor = errors.New("invalid cursor")
 )
 
 type NewSession struct {
-	UserID        int32
-	TaskID        int32
-	TimerDuration int32
+	UserID int32
+	TaskID int32
+	// The defaults of the profile of the user are used when nil
+	TimerDuration *int32
 	BreakDuration *int32
 }
 
+// Fills the durations which are not set with the defaults of the user
+func withDefaultDurations(session NewSession) (NewSession, error) {
+	if session.TimerDuration != nil && session.BreakDuration != nil {
+		return session, nil
+	}
+
+	userProfile, err := profile.Get(session.UserID)
+	if err != nil {
+		return NewSession{}, err
+	}
+
+	if session.TimerDuration == nil {
+		session.TimerDuration = userProfile.DefaultFocusDuration
+		if session.TimerDuration == nil {
+			session.TimerDuration = utils.Ptr(profile.DefaultFocusDuration)
+		}
+	}
+	if session.BreakDuration == nil {
+		session.BreakDuration = userProfile.DefaultBreakDuration
+	}
+
+	return session, nil
+}
+
 func CreateSession(session NewSession) (model.FocusSession, error) {
-	if session.TimerDuration <= 0 {
+	session, err := withDefaultDurations(session)
+	if err != nil {
+		return model.FocusSession{}, err
+	}
+
+	if *session.TimerDuration <= 0 {
 		return model.FocusSession{}, ErrInvalidTimerDuration
 	}
 
@@ -50,6 +86,9 @@ func CreateSession(session NewSession) (model.FocusSession, error) {
 		Where("id = ?", session.TaskID).
 		First(&taskInfo)
 	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return model.FocusSession{}, ErrTaskNotFound
+		}
 		return model.FocusSession{}, result.Error
 	}
 	// if result.RowsAffected != 0 {
@@ -69,7 +108,7 @@ func CreateSession(session NewSession) (model.FocusSession, error) {
 
 	newSession := model.FocusSession{
 		TaskID:        &session.TaskID,
-		TimerDuration: session.TimerDuration,
+		TimerDuration: *session.TimerDuration,
 		Status:        string(StatusActive),
 	}
 	if session.BreakDuration != nil {
@@ -109,10 +148,13 @@ func EndSession(session SessionToEnd) (model.FocusSession, error) {
 	result := database.Instance().
 		Model(&model.FocusSession{}).
 		Select("task.user_id, focus_session.*").
-		Joins("INNER JOIN task ON focus_session.task_id = task.id").
+		Joins("INNER JOIN task ON focus_session.task_id = task.id AND task.deleted_at IS NULL").
 		Where("focus_session.id = ?", session.SessionID).
 		First(&sessionInfo)
 	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return model.FocusSession{}, ErrSessionNotFound
+		}
 		return model.FocusSession{}, result.Error
 	}
 	// if result.RowsAffected != 0 {
@@ -153,6 +195,68 @@ func EndSession(session SessionToEnd) (model.FocusSession, error) {
 	return endedSession, nil
 }
 
+type GetCriteria struct {
+	UserID     int32
+	TaskID     *int32
+	Pagination utils.Pagination
+}
+
+// A session with the value of its sort expression, used to create cursors
+type sortedSession struct {
+	model.FocusSession
+	SortKey *float64
+}
+
+// Gets the sessions of the user's tasks which are not in the trash, most recent first
+func GetSessions(criteria *GetCriteria) ([]model.FocusSession, error) {
+	constructQuery := func(db *gorm.DB) *gorm.DB {
+		query := db.
+			Model(&model.FocusSession{}).
+			Joins("INNER JOIN task ON focus_session.task_id = task.id AND task.deleted_at IS NULL").
+			Where("task.user_id = ?", criteria.UserID)
+
+		if criteria.TaskID != nil {
+			query = query.Where("focus_session.task_id = ?", criteria.TaskID)
+		}
+		return query
+	}
+
+	keyset := cursor.Keyset{
+		Name:       "created_at:desc",
+		Expression: "julianday(focus_session.created_at)",
+		IDColumn:   "focus_session.id",
+		Desc:       true,
+	}
+
+	var sorted []sortedSession
+	err := cursor.Find(
+		constructQuery,
+		"focus_session.*, "+keyset.Expression+" AS sort_key",
+		keyset,
+		&criteria.Pagination,
+		&sorted,
+		func(s sortedSession) (any, int32) {
+			if s.SortKey == nil {
+				return nil, s.ID
+			}
+			return *s.SortKey, s.ID
+		},
+	)
+	if err != nil {
+		if errors.Is(err, cursor.ErrInvalidCursor) {
+			return nil, ErrInvalidCursor
+		}
+		return nil, err
+	}
+
+	sessions := make([]model.FocusSession, len(sorted))
+	for i, s := range sorted {
+		sessions[i] = s.FocusSession
+	}
+
+	return sessions, nil
+}
+
 // type Analytics struct {
 // 	TotalTimeSpent     int32
 // 	TotalEstimatedTime int32
@@ -13,7 +13,7 @@ func (s *Handler) PostActivation(
 	ctx context.Context,
 	request api.PostActivationRequestObject,
 ) (api.PostActivationResponseObject, error) {
-	err := user.ActivateAccount(request.Body.UserId, request.Body.Token)
+	err := user.ActivateAccount(request.Body.UserId, request.Body.Token, api.ClientOfRequest(ctx))
 	if err != nil {
 		switch {
 		case errors.Is(err, user.ErrExpiredToken):
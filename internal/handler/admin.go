@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"study-planner-api/internal/admin"
+	"study-planner-api/internal/api"
+	"study-planner-api/internal/audit"
+	"study-planner-api/internal/auth"
+	"study-planner-api/internal/auth/role"
+	"study-planner-api/internal/user"
+	"study-planner-api/internal/utils"
+)
+
+func toApiAdminUser(u admin.User) api.AdminUser {
+	return api.AdminUser{
+		ID:                  u.ID,
+		Email:               u.Email,
+		DisplayName:         u.DisplayName,
+		Role:                api.Role(role.Parse(u.Role)),
+		IsActivated:         u.IsActivated,
+		CreatedAt:           u.CreatedAt,
+		DisabledAt:          u.DisabledAt,
+		DeletionScheduledAt: u.DeletionScheduledAt,
+		ActiveSessions:      int(u.ActiveSessions),
+	}
+}
+
+// GetAdminUsers implements api.StrictServerInterface.
+func (s *Handler) GetAdminUsers(ctx context.Context, request api.GetAdminUsersRequestObject) (api.GetAdminUsersResponseObject, error) {
+	pagination := paginationFromParams(
+		request.Params.Page,
+		request.Params.Limit,
+		request.Params.Cursor,
+		request.Params.IncludeTotal,
+	)
+
+	filter := admin.UserFilter{Query: request.Params.Q}
+	if request.Params.Role != nil {
+		filter.Role = utils.Ptr(role.Role(*request.Params.Role))
+	}
+	if request.Params.Status != nil {
+		filter.Status = utils.Ptr(admin.Status(*request.Params.Status))
+	}
+
+	users, err := admin.SearchUsers(filter, &pagination)
+	if err != nil {
+		if errors.Is(err, admin.ErrInvalidCursor) {
+			return api.GetAdminUsers400JSONResponse{Message: utils.Ptr("Invalid cursor")}, nil
+		}
+		return nil, err
+	}
+
+	apiUsers := make([]api.AdminUser, len(users))
+	for i, u := range users {
+		apiUsers[i] = toApiAdminUser(u)
+	}
+
+	return api.GetAdminUsers200JSONResponse{
+		Data:       &apiUsers,
+		Pagination: toApiPagination(pagination),
+	}, nil
+}
+
+// GetAdminUsersId implements api.StrictServerInterface.
+func (s *Handler) GetAdminUsersId(ctx context.Context, request api.GetAdminUsersIdRequestObject) (api.GetAdminUsersIdResponseObject, error) {
+	u, err := admin.GetUser(request.Id)
+	if err != nil {
+		if errors.Is(err, admin.ErrUserNotFound) {
+			return api.GetAdminUsersId404JSONResponse{Message: utils.Ptr("User not found")}, nil
+		}
+		return nil, err
+	}
+
+	return api.GetAdminUsersId200JSONResponse(toApiAdminUser(u)), nil
+}
+
+// PostAdminUsersIdDeactivate implements api.StrictServerInterface.
+func (s *Handler) PostAdminUsersIdDeactivate(ctx context.Context, request api.PostAdminUsersIdDeactivateRequestObject) (api.PostAdminUsersIdDeactivateResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	err := auth.DeactivateAccount(request.Id, authInfo.ID, api.ClientOfRequest(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrUserNotFound):
+			return api.PostAdminUsersIdDeactivate404JSONResponse{Message: utils.Ptr("User not found")}, nil
+		case errors.Is(err, auth.ErrSelfDeactivation):
+			return api.PostAdminUsersIdDeactivate409JSONResponse{Message: utils.Ptr("Admins cannot deactivate their own account")}, nil
+		}
+		return nil, err
+	}
+
+	u, err := admin.GetUser(request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostAdminUsersIdDeactivate200JSONResponse(toApiAdminUser(u)), nil
+}
+
+// PostAdminUsersIdReactivate implements api.StrictServerInterface.
+func (s *Handler) PostAdminUsersIdReactivate(ctx context.Context, request api.PostAdminUsersIdReactivateRequestObject) (api.PostAdminUsersIdReactivateResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	err := auth.ReactivateAccount(request.Id, authInfo.ID, api.ClientOfRequest(ctx))
+	if err != nil {
+		if errors.Is(err, auth.ErrUserNotFound) {
+			return api.PostAdminUsersIdReactivate404JSONResponse{Message: utils.Ptr("User not found")}, nil
+		}
+		return nil, err
+	}
+
+	u, err := admin.GetUser(request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostAdminUsersIdReactivate200JSONResponse(toApiAdminUser(u)), nil
+}
+
+// DeleteAdminUsersIdSessions implements api.StrictServerInterface.
+func (s *Handler) DeleteAdminUsersIdSessions(ctx context.Context, request api.DeleteAdminUsersIdSessionsRequestObject) (api.DeleteAdminUsersIdSessionsResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	err := auth.ForceLogout(request.Id, authInfo.ID, api.ClientOfRequest(ctx))
+	if err != nil {
+		if errors.Is(err, auth.ErrUserNotFound) {
+			return api.DeleteAdminUsersIdSessions404JSONResponse{Message: utils.Ptr("User not found")}, nil
+		}
+		return nil, err
+	}
+
+	return api.DeleteAdminUsersIdSessions204Response{}, nil
+}
+
+// PostAdminUsersIdActivationEmail implements api.StrictServerInterface.
+func (s *Handler) PostAdminUsersIdActivationEmail(ctx context.Context, request api.PostAdminUsersIdActivationEmailRequestObject) (api.PostAdminUsersIdActivationEmailResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	err := admin.ResendActivationEmail(request.Id, authInfo.ID, api.ClientOfRequest(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, user.ErrUserNotFound):
+			return api.PostAdminUsersIdActivationEmail404JSONResponse{Message: utils.Ptr("User not found")}, nil
+		case errors.Is(err, user.ErrUserAlreadyActivated):
+			return api.PostAdminUsersIdActivationEmail409JSONResponse{Message: utils.Ptr("User is already activated")}, nil
+		}
+		return nil, err
+	}
+
+	return api.PostAdminUsersIdActivationEmail204Response{}, nil
+}
+
+// GetAdminStats implements api.StrictServerInterface.
+func (s *Handler) GetAdminStats(ctx context.Context, request api.GetAdminStatsRequestObject) (api.GetAdminStatsResponseObject, error) {
+	stats, err := admin.GetStats()
+	if err != nil {
+		return nil, err
+	}
+
+	var res api.GetAdminStats200JSONResponse
+	res.Users.Total = int(stats.Users.Total)
+	res.Users.Activated = int(stats.Users.Activated)
+	res.Users.Disabled = int(stats.Users.Disabled)
+	res.Users.PendingDeletion = int(stats.Users.PendingDeletion)
+	res.Users.Admins = int(stats.Users.Admins)
+	res.Users.Recent = int(stats.Users.Recent)
+	res.ActiveSessions = int(stats.ActiveSessions)
+	res.ActiveUsers = int(stats.ActiveUsers)
+	res.Tasks = int(stats.Tasks)
+	res.FocusSessions = int(stats.FocusSessions)
+	res.FocusTime = int(stats.FocusTime)
+
+	return res, nil
+}
+
+// GetAdminSecurityEvents implements api.StrictServerInterface.
+func (s *Handler) GetAdminSecurityEvents(ctx context.Context, request api.GetAdminSecurityEventsRequestObject) (api.GetAdminSecurityEventsResponseObject, error) {
+	pagination := paginationFromParams(
+		request.Params.Page,
+		request.Params.Limit,
+		request.Params.Cursor,
+		request.Params.IncludeTotal,
+	)
+
+	events, err := audit.List(audit.Filter{
+		UserID:    request.Params.UserId,
+		Types:     eventTypesFromParam(request.Params.Type),
+		IPAddress: request.Params.IpAddress,
+	}, &pagination)
+	if err != nil {
+		if errors.Is(err, audit.ErrInvalidCursor) {
+			return api.GetAdminSecurityEvents400JSONResponse{Message: utils.Ptr("Invalid cursor")}, nil
+		}
+		return nil, err
+	}
+
+	return api.GetAdminSecurityEvents200JSONResponse{
+		Data:       toApiSecurityEvents(events),
+		Pagination: toApiPagination(pagination),
+	}, nil
+}
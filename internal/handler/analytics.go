@@ -24,7 +24,7 @@ func (s *Handler) GetAnalyticsFocus(ctx context.Context, request api.GetAnalytic
 
 	query := database.Instance().
 		Model(&model.FocusSession{}).
-		Joins("JOIN task ON focus_session.task_id = task.id").
+		Joins("JOIN task ON focus_session.task_id = task.id AND task.deleted_at IS NULL").
 		Select("COALESCE(SUM(focus_duration), 0) as total, COALESCE(SUM(estimated_time) * 60, 0) as estimated").
 		Where("task.user_id = ?", userID).
 		Where("focus_session.status <> ?", focussession.StatusActive.String())
@@ -51,7 +51,7 @@ func (s *Handler) GetAnalyticsFocus(ctx context.Context, request api.GetAnalytic
 	}
 	dailyQuery := database.Instance().
 		Model(&model.FocusSession{}).
-		Joins("JOIN task ON focus_session.task_id = task.id").
+		Joins("JOIN task ON focus_session.task_id = task.id AND task.deleted_at IS NULL").
 		Select("DATE(focus_session.created_at) as date, COALESCE(SUM(focus_session.focus_duration), 0) as total").
 		Where("focus_session.status <> ?", focussession.StatusActive.String()).
 		Where("task.user_id = ?", userID).
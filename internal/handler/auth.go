@@ -3,10 +3,12 @@ package handler
 import (
 	"context"
 	"errors"
+	"math"
 	"net/http"
 	"study-planner-api/internal/api"
 	"study-planner-api/internal/auth"
 	"study-planner-api/internal/auth/token"
+	"study-planner-api/internal/model"
 	"study-planner-api/internal/user"
 	"study-planner-api/internal/utils"
 	"time"
@@ -14,15 +16,28 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-// TODO: Add registration data validation
 func (s *Handler) PostRegister(
 	ctx context.Context,
 	request api.PostRegisterRequestObject,
 ) (api.PostRegisterResponseObject, error) {
 	email, password := request.Body.Email, request.Body.Password
 
-	userId, err := user.CreateUser(email, password)
+	if err := s.Validate.Var(email, "required,email"); err != nil {
+		return api.PostRegister400JSONResponse{
+			Type:    utils.Ptr(api.InvalidEmail),
+			Message: utils.Ptr("Invalid email"),
+		}, nil
+	}
+
+	userId, err := user.CreateUser(email, password, api.ClientOfRequest(ctx))
 	if err != nil {
+		if violations, ok := passwordViolationsOf(err); ok {
+			return api.PostRegister400JSONResponse{
+				Type:       utils.Ptr(api.InvalidPassword),
+				Message:    utils.Ptr("Password does not follow the policy"),
+				Violations: violations,
+			}, nil
+		}
 		if errors.Is(err, user.ErrUserExists) {
 			return api.PostRegister400JSONResponse{
 				Type:    utils.Ptr(api.DuplicateEmail),
@@ -33,17 +48,7 @@ func (s *Handler) PostRegister(
 		return nil, err
 	}
 
-	accessToken, refreshToken, err := token.CreateAuthTokens(
-		token.AuthInfo{
-			UserID:      userId,
-			IsActivated: false,
-		},
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	err = auth.CreateSession(userId, refreshToken)
+	accessToken, refreshToken, err := auth.StartSession(userId, auth.LoginRegistration, api.ClientOfRequest(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -63,36 +68,53 @@ func (s *Handler) PostLogin(
 		Password: *request.Body.Password,
 	}
 
-	user, err := auth.VerifyLoginInfo(loginInfo)
+	user, err := auth.VerifyLoginInfo(loginInfo, api.ClientOfRequest(ctx))
 	if err != nil {
+		var tooManyAttempts *auth.TooManyAttemptsError
+
 		switch {
+		case errors.As(err, &tooManyAttempts):
+			return api.PostLogin429JSONResponse{
+				Headers: api.PostLogin429ResponseHeaders{
+					RetryAfter: int(math.Ceil(tooManyAttempts.RetryAfter.Seconds())),
+				},
+				Body: api.DefaultResponse{
+					Message: utils.Ptr("Too many failed login attempts"),
+				},
+			}, nil
 		case errors.Is(err, auth.ErrUserNotFound):
 			fallthrough
+		case errors.Is(err, auth.ErrUserHasNoPassword):
+			fallthrough
 		case errors.Is(err, auth.ErrIncorrectPassword):
 			return api.PostLogin400Response{}, nil
+		case errors.Is(err, auth.ErrAccountDisabled):
+			return api.PostLogin403JSONResponse{AccountDisabledJSONResponse: accountDisabledResponse}, nil
 		default:
 			log.Debug().Err(err).Msg("incorrect password")
 			return nil, err
 		}
 	}
 
-	accessToken, refreshToken, err := token.CreateAuthTokens(
-		token.AuthInfo{
-			UserID:      user.ID,
-			IsActivated: user.IsActivated,
-		},
-	)
-	if err != nil {
-		return nil, err
-	}
-
+	// Users who are not activated only get an access token, to request the activation email
 	if !user.IsActivated {
+		accessToken, err := token.CreateAccessToken(token.AuthInfo{
+			UserID:       user.ID,
+			IsActivated:  user.IsActivated,
+			TokenVersion: user.TokenVersion,
+			Role:         user.Role,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = auth.RecordLogin(user.ID, auth.LoginPassword, api.ClientOfRequest(ctx))
+		if err != nil {
+			return nil, err
+		}
+
 		return api.PostLogin200JSONResponse{
-			Body: struct {
-				AccessToken  *string "json:\"access_token,omitempty\""
-				IsActivated  *bool   "json:\"is_activated,omitempty\""
-				RefreshToken *string "json:\"refresh_token,omitempty\""
-			}{
+			Body: api.LoginResponse{
 				AccessToken:  &accessToken.Value,
 				IsActivated:  &user.IsActivated,
 				RefreshToken: nil,
@@ -100,11 +122,56 @@ func (s *Handler) PostLogin(
 		}, nil
 	}
 
-	err = auth.CreateSession(user.ID, refreshToken)
+	body, cookie, err := completeLogin(ctx, user, auth.LoginPassword)
 	if err != nil {
 		return nil, err
 	}
 
+	return api.PostLogin200JSONResponse{
+		Headers: api.PostLogin200ResponseHeaders{
+			SetCookie: cookie,
+		},
+		Body: body,
+	}, nil
+}
+
+var accountDisabledResponse = api.AccountDisabledJSONResponse{
+	Message: utils.Ptr("Account is disabled"),
+}
+
+// Completes the login of an activated user whose first factor was verified,
+// with a new session, or with an MFA challenge when the user enabled 2FA.
+// Returns the response body and the refresh token cookie, empty without a session.
+// Deactivated users are refused with auth.ErrAccountDisabled.
+func completeLogin(ctx context.Context, user model.User, method auth.LoginMethod) (api.LoginResponse, string, error) {
+	if user.DisabledAt != nil {
+		return api.LoginResponse{}, "", auth.ErrAccountDisabled
+	}
+
+	mfaEnabled, err := auth.IsMfaEnabled(user.ID)
+	if err != nil {
+		return api.LoginResponse{}, "", err
+	}
+
+	// The tokens are only issued once the second factor is verified, at /login/2fa
+	if mfaEnabled {
+		mfaToken, err := auth.StartMfaChallenge(user.ID)
+		if err != nil {
+			return api.LoginResponse{}, "", err
+		}
+
+		return api.LoginResponse{
+			IsActivated: &user.IsActivated,
+			MfaRequired: utils.Ptr(true),
+			MfaToken:    &mfaToken.Value,
+		}, "", nil
+	}
+
+	accessToken, refreshToken, err := auth.StartSession(user.ID, method, api.ClientOfRequest(ctx))
+	if err != nil {
+		return api.LoginResponse{}, "", err
+	}
+
 	cookie := http.Cookie{
 		Name:     "refresh_token",
 		Value:    refreshToken.Value,
@@ -115,20 +182,11 @@ func (s *Handler) PostLogin(
 		Expires:  refreshToken.Expiry.Time(),
 	}
 
-	return api.PostLogin200JSONResponse{
-		Headers: api.PostLogin200ResponseHeaders{
-			SetCookie: cookie.String(),
-		},
-		Body: struct {
-			AccessToken  *string "json:\"access_token,omitempty\""
-			IsActivated  *bool   "json:\"is_activated,omitempty\""
-			RefreshToken *string "json:\"refresh_token,omitempty\""
-		}{
-			AccessToken:  &accessToken.Value,
-			IsActivated:  &user.IsActivated,
-			RefreshToken: &refreshToken.Value,
-		},
-	}, nil
+	return api.LoginResponse{
+		AccessToken:  &accessToken.Value,
+		IsActivated:  &user.IsActivated,
+		RefreshToken: &refreshToken.Value,
+	}, cookie.String(), nil
 }
 
 func (s *Handler) PostAuthRefreshToken(
@@ -145,22 +203,11 @@ func (s *Handler) PostAuthRefreshToken(
 		return api.PostAuthRefreshToken403Response{}, nil
 	}
 
-	info, _, err := token.ValidateRefreshToken(refreshToken)
-	if err != nil {
-		return api.PostAuthRefreshToken403Response{}, nil
-	}
-
-	newAccessToken, newRefreshToken, err := token.CreateAuthTokens(token.AuthInfo{
-		UserID:      info.UserID,
-		IsActivated: info.IsActivated,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	err = auth.UpdateSession(info.UserID, refreshToken, newRefreshToken)
+	newAccessToken, newRefreshToken, err := auth.RefreshSession(refreshToken, api.ClientOfRequest(ctx))
 	if err != nil {
-		if errors.Is(err, auth.ErrMaliciousRefreshToken) {
+		if errors.Is(err, auth.ErrInvalidToken) ||
+			errors.Is(err, auth.ErrMaliciousRefreshToken) ||
+			errors.Is(err, auth.ErrRefreshTokenReused) {
 			return api.PostAuthRefreshToken403Response{}, nil
 		}
 
@@ -221,7 +268,7 @@ func (s *Handler) PostLogout(
 		}, nil
 	}
 
-	err = auth.RemoveSession(info.UserID, refreshToken)
+	err = auth.RemoveSession(info, refreshToken, api.ClientOfRequest(ctx))
 	if err != nil {
 		return api.PostLogout403Response{
 			Headers: api.PostLogout403ResponseHeaders{
@@ -236,3 +283,70 @@ func (s *Handler) PostLogout(
 		},
 	}, nil
 }
+
+func (s *Handler) PostAuthMagicLink(
+	ctx context.Context,
+	request api.PostAuthMagicLinkRequestObject,
+) (api.PostAuthMagicLinkResponseObject, error) {
+	email := request.Body.Email
+	if err := s.Validate.Var(email, "required,email"); err != nil {
+		return api.PostAuthMagicLink400Response{}, nil
+	}
+
+	err := auth.SendMagicLinkEmail(email)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrUnknownEmail):
+			// Still return 200 to prevent email enumeration
+			return api.PostAuthMagicLink200Response{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.PostAuthMagicLink200Response{}, nil
+}
+
+func (s *Handler) PostAuthMagicLinkConfirm(
+	ctx context.Context,
+	request api.PostAuthMagicLinkConfirmRequestObject,
+) (api.PostAuthMagicLinkConfirmResponseObject, error) {
+	user, err := auth.ConfirmMagicLink(request.Body.UserId, request.Body.Token)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidToken):
+			return api.PostAuthMagicLinkConfirm403JSONResponse{
+				TokenErrorJSONResponse: api.TokenErrorJSONResponse{
+					Type: utils.Ptr(api.InvalidToken),
+				},
+			}, nil
+		case errors.Is(err, auth.ErrExpiredToken):
+			return api.PostAuthMagicLinkConfirm403JSONResponse{
+				TokenErrorJSONResponse: api.TokenErrorJSONResponse{
+					Type: utils.Ptr(api.ExpiredToken),
+				},
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	body, cookie, err := completeLogin(ctx, user, auth.LoginMagicLink)
+	if err != nil {
+		if errors.Is(err, auth.ErrAccountDisabled) {
+			return api.PostAuthMagicLinkConfirm403JSONResponse{
+				TokenErrorJSONResponse: api.TokenErrorJSONResponse{
+					Message: accountDisabledResponse.Message,
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.PostAuthMagicLinkConfirm200JSONResponse{
+		Headers: api.PostAuthMagicLinkConfirm200ResponseHeaders{
+			SetCookie: cookie,
+		},
+		Body: body,
+	}, nil
+}
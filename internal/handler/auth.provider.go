@@ -0,0 +1,266 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"study-planner-api/internal/api"
+	"study-planner-api/internal/auth"
+	"study-planner-api/internal/auth/provider"
+	"study-planner-api/internal/auth/token"
+	"study-planner-api/internal/utils"
+
+	"github.com/rs/zerolog/log"
+)
+
+func (s *Handler) GetAuthProviderAuthorize(ctx context.Context, request api.GetAuthProviderAuthorizeRequestObject) (api.GetAuthProviderAuthorizeResponseObject, error) {
+	p, err := provider.Get(request.Provider)
+	if err != nil {
+		return api.GetAuthProviderAuthorize404JSONResponse{Message: utils.Ptr("Unknown provider")}, nil
+	}
+
+	// The binding cookie is only stored by the browser for a top-level navigation,
+	// browsers without fetch metadata are let through
+	if request.Params.SecFetchMode != nil && *request.Params.SecFetchMode != "navigate" {
+		return api.GetAuthProviderAuthorize400JSONResponse{
+			Message: utils.Ptr("The authorization must be opened as a navigation of the browser, not with fetch or XHR"),
+		}, nil
+	}
+
+	hostUrl := api.HostUrlOfRequest(ctx)
+	log.Debug().Msgf("Host URL: %s", hostUrl)
+
+	app := token.RequestApplication{Host: hostUrl}
+	if request.Params.Mode != nil {
+		app.Mode = string(*request.Params.Mode)
+	}
+	if request.Params.ReturnTo != nil {
+		app.ReturnTo = *request.Params.ReturnTo
+	}
+	if request.Params.CodeChallenge != nil {
+		app.CodeChallenge = *request.Params.CodeChallenge
+	}
+
+	app, err = provider.GetDeliveryPolicy().Validate(app)
+	if err != nil {
+		return api.GetAuthProviderAuthorize400JSONResponse{Message: utils.Ptr(err.Error())}, nil
+	}
+
+	// Other apps can register the same scheme and intercept deep links, so their codes are bound to the app
+	if app.Mode == provider.DeliveryDeepLink && app.CodeChallenge == "" {
+		return api.GetAuthProviderAuthorize400JSONResponse{
+			Message: utils.Ptr("code_challenge is required for deep links"),
+		}, nil
+	}
+
+	authUrl, binding, err := provider.Authorize(ctx, p, token.StateToken{RequestApplication: app})
+	if err != nil {
+		return nil, err
+	}
+
+	cookie := authBindingCookie(binding)
+
+	return api.GetAuthProviderAuthorize303Response{
+		Headers: api.GetAuthProviderAuthorize303ResponseHeaders{
+			Location:  authUrl,
+			SetCookie: cookie.String(),
+		},
+	}, nil
+}
+
+// Cookie binding an authorization to the browser which started it.
+// Lax, so that it is sent with the redirection of the provider to the callback.
+func authBindingCookie(binding string) http.Cookie {
+	return http.Cookie{
+		Name:     "auth_binding",
+		Value:    binding,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+func (s *Handler) GetAuthProviderCallback(ctx context.Context, request api.GetAuthProviderCallbackRequestObject) (api.GetAuthProviderCallbackResponseObject, error) {
+	p, err := provider.Get(request.Provider)
+	if err != nil {
+		return api.GetAuthProviderCallback404JSONResponse{Message: utils.Ptr("Unknown provider")}, nil
+	}
+
+	state, err := token.ValidateOauth2StateToken(request.Params.State, p.Name())
+	if err != nil {
+		return api.GetAuthProviderCallback400JSONResponse{Message: utils.Ptr("Invalid state")}, nil
+	}
+
+	// Otherwise a state could be sent to someone else, to link their identity to the account which started it
+	if request.Params.AuthBinding == nil || !state.IsBoundTo(*request.Params.AuthBinding) {
+		return api.GetAuthProviderCallback400JSONResponse{
+			Message: utils.Ptr("Authorization was not started by this browser"),
+		}, nil
+	}
+	app := state.RequestApplication
+
+	// From here on, the result is delivered to the application, errors included
+	if request.Params.Error != nil || request.Params.Code == nil {
+		return deliverCallbackResult(app, callbackResult{Error: "access_denied"})
+	}
+
+	identity, state, err := provider.Callback(ctx, p, *request.Params.Code, request.Params.State)
+	if err != nil {
+		log.Error().Err(err).Str("provider", p.Name()).Msg("failed to complete authorization")
+		return deliverCallbackResult(app, callbackResult{Error: "authorization_failed"})
+	}
+
+	if state.LinkUserID != 0 {
+		err = provider.LinkIdentity(state.LinkUserID, p.Name(), identity, api.ClientOfRequest(ctx))
+		if err != nil {
+			if errors.Is(err, provider.ErrIdentityLinked) {
+				return deliverCallbackResult(app, callbackResult{Error: "identity_linked_to_other_account"})
+			}
+			return nil, err
+		}
+
+		return deliverCallbackResult(app, callbackResult{LinkedProvider: p.Name()})
+	}
+
+	user, err := provider.ResolveUser(p.Name(), identity, api.ClientOfRequest(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, provider.ErrUnverifiedEmail):
+			return deliverCallbackResult(app, callbackResult{Error: "unverified_email"})
+		case errors.Is(err, provider.ErrUnverifiedAccount):
+			return deliverCallbackResult(app, callbackResult{Error: "unverified_account"})
+		}
+		return nil, errors.Join(err, errors.New("failed to resolve user of identity"))
+	}
+
+	// Popups only post to their opener's origin, others are given a code to redeem instead of the tokens,
+	// since URLs leak through history, logs and other apps registering the same scheme
+	if app.Mode != provider.DeliveryPopup {
+		code, err := auth.CreateExchangeCode(user.ID, app.CodeChallenge)
+		if err != nil {
+			return nil, err
+		}
+
+		return deliverCallbackResult(app, callbackResult{Code: code})
+	}
+
+	// Like other logins, users who enabled 2FA must still verify their second factor at /login/2fa
+	body, _, err := completeLogin(ctx, user, auth.LoginProvider)
+	if err != nil {
+		if errors.Is(err, auth.ErrAccountDisabled) {
+			return deliverCallbackResult(app, callbackResult{Error: "account_disabled"})
+		}
+		return nil, err
+	}
+
+	if body.MfaToken != nil {
+		return deliverCallbackResult(app, callbackResult{MfaToken: *body.MfaToken})
+	}
+
+	return deliverCallbackResult(app, callbackResult{
+		AccessToken:  *body.AccessToken,
+		RefreshToken: *body.RefreshToken,
+	})
+}
+
+// Result of a callback, only one of its fields is set besides the tokens
+type callbackResult struct {
+	AccessToken    string `json:"accessToken,omitempty"`
+	RefreshToken   string `json:"refreshToken,omitempty"`
+	MfaToken       string `json:"mfaToken,omitempty"`
+	Code           string `json:"-"`
+	LinkedProvider string `json:"linkedProvider,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+var popupPageTemplate = template.Must(template.New("popup").Parse(`<!doctype html>
+<html>
+<title>Authentication</title>
+<script>
+	window.opener.postMessage({{.Result}}, {{.Origin}});
+	window.close();
+</script>
+</html>
+`))
+
+type popupPageData struct {
+	Result callbackResult
+	Origin string
+}
+
+// Delivers the result of the callback to the application, as chosen when the authorization started
+func deliverCallbackResult(app token.RequestApplication, result callbackResult) (api.GetAuthProviderCallbackResponseObject, error) {
+	// Validated states always have both, the result is never delivered to any other application
+	if app.Mode == "" || app.ReturnTo == "" {
+		return api.GetAuthProviderCallback400JSONResponse{Message: utils.Ptr("Invalid state")}, nil
+	}
+
+	if app.Mode == provider.DeliveryPopup {
+		var page strings.Builder
+		err := popupPageTemplate.Execute(&page, popupPageData{Result: result, Origin: app.ReturnTo})
+		if err != nil {
+			return nil, err
+		}
+
+		return api.GetAuthProviderCallback200TexthtmlResponse{
+			Body:          strings.NewReader(page.String()),
+			ContentLength: int64(page.Len()),
+		}, nil
+	}
+
+	returnTo, err := url.Parse(app.ReturnTo)
+	if err != nil {
+		return nil, err
+	}
+
+	q := returnTo.Query()
+	switch {
+	case result.Code != "":
+		q.Set("code", result.Code)
+	case result.LinkedProvider != "":
+		q.Set("linked_provider", result.LinkedProvider)
+	default:
+		q.Set("error", result.Error)
+	}
+	returnTo.RawQuery = q.Encode()
+
+	return api.GetAuthProviderCallback303Response{
+		Headers: api.GetAuthProviderCallback303ResponseHeaders{
+			Location: returnTo.String(),
+		},
+	}, nil
+}
+
+func (s *Handler) PostAuthExchange(ctx context.Context, request api.PostAuthExchangeRequestObject) (api.PostAuthExchangeResponseObject, error) {
+	var codeVerifier string
+	if request.Body.CodeVerifier != nil {
+		codeVerifier = *request.Body.CodeVerifier
+	}
+
+	user, err := auth.RedeemExchangeCode(request.Body.Code, codeVerifier)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidExchangeCode) {
+			return api.PostAuthExchange400JSONResponse{Message: utils.Ptr("Invalid or expired code")}, nil
+		}
+		return nil, err
+	}
+
+	body, cookie, err := completeLogin(ctx, user, auth.LoginProvider)
+	if err != nil {
+		if errors.Is(err, auth.ErrAccountDisabled) {
+			return api.PostAuthExchange403JSONResponse{AccountDisabledJSONResponse: accountDisabledResponse}, nil
+		}
+		return nil, err
+	}
+
+	return api.PostAuthExchange200JSONResponse{
+		Headers: api.PostAuthExchange200ResponseHeaders{
+			SetCookie: cookie,
+		},
+		Body: body,
+	}, nil
+}
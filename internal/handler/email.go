@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/mail"
+	"study-planner-api/internal/api"
+	"study-planner-api/internal/auth"
+	"study-planner-api/internal/utils"
+)
+
+// PostProfileEmail implements api.StrictServerInterface.
+func (s *Handler) PostProfileEmail(ctx context.Context, request api.PostProfileEmailRequestObject) (api.PostProfileEmailResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	address, err := mail.ParseAddress(request.Body.Email)
+	if err != nil || address.Address != request.Body.Email {
+		return api.PostProfileEmail400JSONResponse{Message: utils.Ptr("Invalid email")}, nil
+	}
+
+	err = auth.RequestEmailChange(
+		authInfo.ID,
+		authInfo.SessionID,
+		request.Body.Email,
+		request.Body.Password,
+		api.ClientOfRequest(ctx),
+	)
+	if err != nil {
+		var tooManyAttempts *auth.TooManyAttemptsError
+		switch {
+		case errors.As(err, &tooManyAttempts):
+			return api.PostProfileEmail429JSONResponse{
+				TooManyRequestsJSONResponse: api.TooManyRequestsJSONResponse{
+					Headers: api.TooManyRequestsResponseHeaders{
+						RetryAfter: int(math.Ceil(tooManyAttempts.RetryAfter.Seconds())),
+					},
+					Body: api.DefaultResponse{
+						Message: utils.Ptr("Too many failed password attempts"),
+					},
+				},
+			}, nil
+		case errors.Is(err, auth.ErrIncorrectPassword):
+			return api.PostProfileEmail400JSONResponse{
+				Type:    utils.Ptr(api.IncorrectPassword),
+				Message: utils.Ptr("Missing or incorrect password"),
+			}, nil
+		case errors.Is(err, auth.ErrReauthenticationRequired):
+			return api.PostProfileEmail401JSONResponse{
+				Message: utils.Ptr("Log in again to change the email"),
+			}, nil
+		case errors.Is(err, auth.ErrSameEmail):
+			return api.PostProfileEmail400JSONResponse{Message: utils.Ptr("Email is already the one of the account")}, nil
+		case errors.Is(err, auth.ErrEmailInUse):
+			return api.PostProfileEmail409JSONResponse{Message: utils.Ptr("Email is used by another account")}, nil
+		}
+		return nil, err
+	}
+
+	return api.PostProfileEmail200Response{}, nil
+}
+
+// PostProfileEmailConfirm implements api.StrictServerInterface.
+func (s *Handler) PostProfileEmailConfirm(ctx context.Context, request api.PostProfileEmailConfirmRequestObject) (api.PostProfileEmailConfirmResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	err := auth.ConfirmEmailChange(authInfo.ID, authInfo.SessionID, request.Body.Token, api.ClientOfRequest(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrExpiredToken):
+			return api.PostProfileEmailConfirm400JSONResponse{
+				TokenErrorJSONResponse: api.TokenErrorJSONResponse{
+					Type: utils.Ptr(api.ExpiredToken),
+				},
+			}, nil
+		case errors.Is(err, auth.ErrInvalidToken):
+			return api.PostProfileEmailConfirm400JSONResponse{
+				TokenErrorJSONResponse: api.TokenErrorJSONResponse{
+					Type: utils.Ptr(api.InvalidToken),
+				},
+			}, nil
+		case errors.Is(err, auth.ErrEmailInUse):
+			return api.PostProfileEmailConfirm409JSONResponse{
+				Message: utils.Ptr("Email is used by another account"),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.PostProfileEmailConfirm200Response{}, nil
+}
@@ -22,7 +22,8 @@ func (s *Handler) PostFocusSessions(ctx context.Context, request api.PostFocusSe
 			errors.Is(err, focussession.ErrTaskNotFound) {
 			return api.PostFocusSessions404Response{}, nil
 		}
-		if errors.Is(err, focussession.ErrTaskNotInProgress) {
+		if errors.Is(err, focussession.ErrTaskNotInProgress) ||
+			errors.Is(err, focussession.ErrInvalidTimerDuration) {
 			return api.PostFocusSessions400Response{}, nil
 		}
 
@@ -58,7 +59,8 @@ func (s *Handler) PostFocusSessionsIdEnd(ctx context.Context, request api.PostFo
 
 	endedSession, err := focussession.EndSession(session)
 	if err != nil {
-		if errors.Is(err, focussession.ErrSessionNotFound) {
+		if errors.Is(err, focussession.ErrSessionNotFound) ||
+			errors.Is(err, focussession.ErrSessionNotBelongToUser) {
 			return api.PostFocusSessionsIdEnd404Response{}, nil
 		}
 		if errors.Is(err, focussession.ErrSessionNotActive) {
@@ -80,3 +82,47 @@ func (s *Handler) PostFocusSessionsIdEnd(ctx context.Context, request api.PostFo
 		UpdatedAt:     endedSession.UpdatedAt,
 	}, nil
 }
+
+// GetFocusSessions implements api.StrictServerInterface.
+func (s *Handler) GetFocusSessions(ctx context.Context, request api.GetFocusSessionsRequestObject) (api.GetFocusSessionsResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	criteria := focussession.GetCriteria{
+		UserID: authInfo.ID,
+		TaskID: request.Params.TaskId,
+		Pagination: paginationFromParams(
+			request.Params.Page,
+			request.Params.Limit,
+			request.Params.Cursor,
+			request.Params.IncludeTotal,
+		),
+	}
+
+	sessions, err := focussession.GetSessions(&criteria)
+	if err != nil {
+		if errors.Is(err, focussession.ErrInvalidCursor) {
+			return api.GetFocusSessions400Response{}, nil
+		}
+		return nil, err
+	}
+
+	apiSessions := make([]api.FocusSession, len(sessions))
+	for i, session := range sessions {
+		apiSessions[i] = api.FocusSession{
+			Id:            &session.ID,
+			UserId:        &authInfo.ID,
+			TaskId:        session.TaskID,
+			TimerDuration: &session.TimerDuration,
+			BreakDuration: session.BreakDuration,
+			Status:        &session.Status,
+			FocusDuration: session.FocusDuration,
+			CreatedAt:     session.CreatedAt,
+			UpdatedAt:     session.UpdatedAt,
+		}
+	}
+
+	return api.GetFocusSessions200JSONResponse{
+		Data:       &apiSessions,
+		Pagination: toApiPagination(criteria.Pagination),
+	}, nil
+}
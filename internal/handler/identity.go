@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"study-planner-api/internal/api"
+	"study-planner-api/internal/auth/provider"
+	"study-planner-api/internal/auth/token"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/utils"
+)
+
+func toApiLinkedIdentity(identity model.ExternalIdentity) api.LinkedIdentity {
+	return api.LinkedIdentity{
+		Id:         &identity.ID,
+		Provider:   &identity.Provider,
+		Email:      identity.Email,
+		CreatedAt:  identity.CreatedAt,
+		LastUsedAt: identity.LastUsedAt,
+	}
+}
+
+// PostProfileIdentities implements api.StrictServerInterface.
+func (s *Handler) PostProfileIdentities(ctx context.Context, request api.PostProfileIdentitiesRequestObject) (api.PostProfileIdentitiesResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	p, err := provider.Get(request.Body.Provider)
+	if err != nil {
+		return api.PostProfileIdentities404JSONResponse{Message: utils.Ptr("Unknown provider")}, nil
+	}
+
+	app := token.RequestApplication{Host: api.HostUrlOfRequest(ctx)}
+	if request.Body.Mode != nil {
+		app.Mode = string(*request.Body.Mode)
+	}
+	if request.Body.ReturnTo != nil {
+		app.ReturnTo = *request.Body.ReturnTo
+	}
+
+	app, err = provider.GetDeliveryPolicy().Validate(app)
+	if err != nil {
+		return api.PostProfileIdentities400JSONResponse{Message: utils.Ptr(err.Error())}, nil
+	}
+
+	authUrl, binding, err := provider.Authorize(ctx, p, token.StateToken{
+		RequestApplication: app,
+		LinkUserID:         authInfo.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cookie := authBindingCookie(binding)
+
+	response := api.PostProfileIdentities200JSONResponse{
+		Headers: api.PostProfileIdentities200ResponseHeaders{SetCookie: cookie.String()},
+	}
+	response.Body.AuthorizationUrl = authUrl
+
+	return response, nil
+}
+
+// DeleteProfileIdentitiesId implements api.StrictServerInterface.
+func (s *Handler) DeleteProfileIdentitiesId(ctx context.Context, request api.DeleteProfileIdentitiesIdRequestObject) (api.DeleteProfileIdentitiesIdResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	err := provider.UnlinkIdentityOfUser(request.Id, authInfo.ID, api.ClientOfRequest(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, provider.ErrIdentityNotFound):
+			return api.DeleteProfileIdentitiesId404JSONResponse{Message: utils.Ptr("Identity not found")}, nil
+		case errors.Is(err, provider.ErrLastLoginMethod):
+			return api.DeleteProfileIdentitiesId409JSONResponse{
+				Message: utils.Ptr("Set a password or add a passkey before unlinking the last identity"),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.DeleteProfileIdentitiesId204Response{}, nil
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"study-planner-api/internal/api"
+	"study-planner-api/internal/auth/token"
+	"time"
+)
+
+// GetWellKnownJwksJson implements api.StrictServerInterface.
+func (s *Handler) GetWellKnownJwksJson(ctx context.Context, request api.GetWellKnownJwksJsonRequestObject) (api.GetWellKnownJwksJsonResponseObject, error) {
+	jwks := token.GetKeyring().PublicKeys(time.Now())
+
+	// The keys are only serialized correctly by the library
+	content, err := json.Marshal(jwks)
+	if err != nil {
+		return nil, err
+	}
+
+	var res api.GetWellKnownJwksJson200JSONResponse
+	err = json.Unmarshal(content, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"study-planner-api/internal/api"
+	"study-planner-api/internal/auth"
+	"study-planner-api/internal/utils"
+)
+
+// PostLogin2fa implements api.StrictServerInterface.
+func (s *Handler) PostLogin2fa(ctx context.Context, request api.PostLogin2faRequestObject) (api.PostLogin2faResponseObject, error) {
+	accessToken, refreshToken, err := auth.CompleteMfaLogin(
+		request.Body.MfaToken,
+		request.Body.Code,
+		api.ClientOfRequest(ctx),
+	)
+	if err != nil {
+		var tooManyAttempts *auth.TooManyAttemptsError
+		switch {
+		case errors.As(err, &tooManyAttempts):
+			return api.PostLogin2fa429JSONResponse{
+				TooManyRequestsJSONResponse: api.TooManyRequestsJSONResponse{
+					Headers: api.TooManyRequestsResponseHeaders{
+						RetryAfter: int(math.Ceil(tooManyAttempts.RetryAfter.Seconds())),
+					},
+					Body: api.DefaultResponse{
+						Message: utils.Ptr("Too many invalid codes"),
+					},
+				},
+			}, nil
+		case errors.Is(err, auth.ErrInvalidToken), errors.Is(err, auth.ErrMfaNotEnabled):
+			return api.PostLogin2fa401JSONResponse{
+				Message: utils.Ptr("Invalid or expired MFA token"),
+			}, nil
+		case errors.Is(err, auth.ErrInvalidMfaCode):
+			return api.PostLogin2fa400JSONResponse{
+				Message: utils.Ptr("Invalid code"),
+			}, nil
+		case errors.Is(err, auth.ErrAccountDisabled):
+			return api.PostLogin2fa403JSONResponse{AccountDisabledJSONResponse: accountDisabledResponse}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	cookie := http.Cookie{
+		Name:     "refresh_token",
+		Value:    refreshToken.Value,
+		HttpOnly: true,
+		Secure:   true,
+		Path:     "/",
+		SameSite: http.SameSiteNoneMode,
+		Expires:  refreshToken.Expiry.Time(),
+	}
+
+	return api.PostLogin2fa200JSONResponse{
+		Headers: api.PostLogin2fa200ResponseHeaders{
+			SetCookie: cookie.String(),
+		},
+		Body: api.AuthTokens{
+			AccessToken:  &accessToken.Value,
+			RefreshToken: &refreshToken.Value,
+		},
+	}, nil
+}
+
+// PostProfile2faTotp implements api.StrictServerInterface.
+func (s *Handler) PostProfile2faTotp(ctx context.Context, request api.PostProfile2faTotpRequestObject) (api.PostProfile2faTotpResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	enrollment, err := auth.SetupTotp(authInfo.ID)
+	if err != nil {
+		if errors.Is(err, auth.ErrMfaAlreadyEnabled) {
+			return api.PostProfile2faTotp409JSONResponse{
+				Message: utils.Ptr("2FA is already enabled"),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.PostProfile2faTotp200JSONResponse{
+		Secret:     &enrollment.Secret,
+		OtpauthUri: &enrollment.URI,
+	}, nil
+}
+
+// PostProfile2faTotpConfirm implements api.StrictServerInterface.
+func (s *Handler) PostProfile2faTotpConfirm(ctx context.Context, request api.PostProfile2faTotpConfirmRequestObject) (api.PostProfile2faTotpConfirmResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	recoveryCodes, err := auth.ConfirmTotp(authInfo.ID, request.Body.Code, api.ClientOfRequest(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidMfaCode):
+			return api.PostProfile2faTotpConfirm400JSONResponse{
+				Message: utils.Ptr("Invalid code"),
+			}, nil
+		case errors.Is(err, auth.ErrMfaNotSetUp):
+			return api.PostProfile2faTotpConfirm404JSONResponse{
+				Message: utils.Ptr("No TOTP enrollment started"),
+			}, nil
+		case errors.Is(err, auth.ErrMfaAlreadyEnabled):
+			return api.PostProfile2faTotpConfirm409JSONResponse{
+				Message: utils.Ptr("2FA is already enabled"),
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.PostProfile2faTotpConfirm200JSONResponse{
+		RecoveryCodes: &recoveryCodes,
+	}, nil
+}
+
+// PostProfile2faDisable implements api.StrictServerInterface.
+func (s *Handler) PostProfile2faDisable(ctx context.Context, request api.PostProfile2faDisableRequestObject) (api.PostProfile2faDisableResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	err := auth.DisableTotp(authInfo.ID, request.Body.Code, api.ClientOfRequest(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidMfaCode):
+			return api.PostProfile2faDisable400JSONResponse{
+				Message: utils.Ptr("Invalid code"),
+			}, nil
+		case errors.Is(err, auth.ErrMfaNotEnabled):
+			return api.PostProfile2faDisable404JSONResponse{
+				Message: utils.Ptr("2FA is not enabled"),
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.PostProfile2faDisable204Response{}, nil
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"study-planner-api/internal/api"
+	"study-planner-api/internal/utils"
+)
+
+const (
+	PageDefault  int = 1
+	LimitDefault int = 10
+)
+
+func paginationFromParams(
+	page *api.PageParam,
+	limit *api.LimitParam,
+	cursor *api.CursorParam,
+	includeTotal *api.IncludeTotalParam,
+) utils.Pagination {
+	pagination := utils.Pagination{
+		Page:   PageDefault,
+		Limit:  LimitDefault,
+		Cursor: cursor,
+	}
+
+	if page != nil {
+		pagination.Page = *page
+	}
+	if limit != nil {
+		pagination.Limit = *limit
+	}
+	if includeTotal != nil {
+		pagination.WithTotal = *includeTotal
+	}
+
+	return pagination
+}
+
+func toApiPagination(pagination utils.Pagination) *api.PaginationResponse {
+	res := api.PaginationResponse{
+		Limit:      &pagination.Limit,
+		NextCursor: pagination.NextCursor,
+	}
+
+	if pagination.Cursor == nil {
+		res.Page = &pagination.Page
+	}
+	if pagination.WithTotal {
+		res.Total = &pagination.Total
+		res.TotalPages = &pagination.TotalPages
+	}
+
+	return &res
+}
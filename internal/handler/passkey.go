@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"study-planner-api/internal/api"
+	"study-planner-api/internal/auth"
+	"study-planner-api/internal/auth/token"
+	"study-planner-api/internal/auth/webauthn"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/utils"
+)
+
+func toApiPasskey(passkey model.WebauthnCredential) api.Passkey {
+	return api.Passkey{
+		Id:         &passkey.ID,
+		Name:       passkey.Name,
+		CreatedAt:  passkey.CreatedAt,
+		LastUsedAt: passkey.LastUsedAt,
+	}
+}
+
+func toApiPasskeyOptions(options any, challengeToken token.JwtToken) (api.PasskeyOptions, error) {
+	content, err := json.Marshal(options)
+	if err != nil {
+		return api.PasskeyOptions{}, err
+	}
+
+	var apiOptions map[string]interface{}
+	err = json.Unmarshal(content, &apiOptions)
+	if err != nil {
+		return api.PasskeyOptions{}, err
+	}
+
+	return api.PasskeyOptions{
+		ChallengeToken: &challengeToken.Value,
+		Options:        &apiOptions,
+	}, nil
+}
+
+// Decodes the base64url encoded values, stopping at the first invalid one
+func decodeBase64Url(values ...*string) ([][]byte, bool) {
+	decoded := make([][]byte, len(values))
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+
+		var err error
+		decoded[i], err = webauthn.Encoding.DecodeString(*value)
+		if err != nil {
+			return nil, false
+		}
+	}
+
+	return decoded, true
+}
+
+// GetProfilePasskeys implements api.StrictServerInterface.
+func (s *Handler) GetProfilePasskeys(ctx context.Context, request api.GetProfilePasskeysRequestObject) (api.GetProfilePasskeysResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	passkeys, err := auth.GetPasskeys(authInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiPasskeys := make([]api.Passkey, len(passkeys))
+	for i, passkey := range passkeys {
+		apiPasskeys[i] = toApiPasskey(passkey)
+	}
+
+	return api.GetProfilePasskeys200JSONResponse(apiPasskeys), nil
+}
+
+// PostProfilePasskeysOptions implements api.StrictServerInterface.
+func (s *Handler) PostProfilePasskeysOptions(ctx context.Context, request api.PostProfilePasskeysOptionsRequestObject) (api.PostProfilePasskeysOptionsResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	options, challengeToken, err := auth.BeginPasskeyRegistration(authInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := toApiPasskeyOptions(options, challengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostProfilePasskeysOptions200JSONResponse(res), nil
+}
+
+// PostProfilePasskeys implements api.StrictServerInterface.
+func (s *Handler) PostProfilePasskeys(ctx context.Context, request api.PostProfilePasskeysRequestObject) (api.PostProfilePasskeysResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	credential := request.Body.Credential
+	decoded, ok := decodeBase64Url(&credential.Response.ClientDataJSON, &credential.Response.AttestationObject)
+	if !ok {
+		return api.PostProfilePasskeys400JSONResponse{
+			Message: utils.Ptr("Invalid credential encoding"),
+		}, nil
+	}
+
+	passkey, err := auth.FinishPasskeyRegistration(
+		authInfo.ID,
+		request.Body.ChallengeToken,
+		request.Body.Name,
+		webauthn.AttestationResponse{
+			ClientDataJSON:    decoded[0],
+			AttestationObject: decoded[1],
+		},
+		api.ClientOfRequest(ctx),
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidToken):
+			return api.PostProfilePasskeys400JSONResponse{
+				Message: utils.Ptr("Invalid or expired challenge token"),
+			}, nil
+		case errors.Is(err, auth.ErrInvalidPasskey):
+			return api.PostProfilePasskeys400JSONResponse{
+				Message: utils.Ptr("Invalid credential"),
+			}, nil
+		case errors.Is(err, auth.ErrPasskeyExists):
+			return api.PostProfilePasskeys409JSONResponse{
+				Message: utils.Ptr("Passkey already registered"),
+			}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.PostProfilePasskeys201JSONResponse(toApiPasskey(passkey)), nil
+}
+
+// DeleteProfilePasskeysId implements api.StrictServerInterface.
+func (s *Handler) DeleteProfilePasskeysId(ctx context.Context, request api.DeleteProfilePasskeysIdRequestObject) (api.DeleteProfilePasskeysIdResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	err := auth.RemovePasskeyOfUser(request.Id, authInfo.ID, api.ClientOfRequest(ctx))
+	if err != nil {
+		if errors.Is(err, auth.ErrPasskeyNotFound) {
+			return api.DeleteProfilePasskeysId404JSONResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return api.DeleteProfilePasskeysId204Response{}, nil
+}
+
+// PostAuthPasskeyOptions implements api.StrictServerInterface.
+func (s *Handler) PostAuthPasskeyOptions(ctx context.Context, request api.PostAuthPasskeyOptionsRequestObject) (api.PostAuthPasskeyOptionsResponseObject, error) {
+	options, challengeToken, err := auth.BeginPasskeyLogin()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := toApiPasskeyOptions(options, challengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostAuthPasskeyOptions200JSONResponse(res), nil
+}
+
+// PostAuthPasskeyLogin implements api.StrictServerInterface.
+func (s *Handler) PostAuthPasskeyLogin(ctx context.Context, request api.PostAuthPasskeyLoginRequestObject) (api.PostAuthPasskeyLoginResponseObject, error) {
+	invalidPasskey := api.PostAuthPasskeyLogin401JSONResponse{
+		Message: utils.Ptr("Invalid or expired challenge token, or invalid passkey"),
+	}
+
+	credential := request.Body.Credential
+	decoded, ok := decodeBase64Url(
+		&credential.Response.ClientDataJSON,
+		&credential.Response.AuthenticatorData,
+		&credential.Response.Signature,
+		credential.Response.UserHandle,
+	)
+	if !ok {
+		return invalidPasskey, nil
+	}
+
+	accessToken, refreshToken, err := auth.FinishPasskeyLogin(
+		request.Body.ChallengeToken,
+		credential.Id,
+		webauthn.AssertionResponse{
+			ClientDataJSON:    decoded[0],
+			AuthenticatorData: decoded[1],
+			Signature:         decoded[2],
+			UserHandle:        decoded[3],
+		},
+		api.ClientOfRequest(ctx),
+	)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidToken) || errors.Is(err, auth.ErrInvalidPasskey) {
+			return invalidPasskey, nil
+		}
+		if errors.Is(err, auth.ErrAccountDisabled) {
+			return api.PostAuthPasskeyLogin403JSONResponse{AccountDisabledJSONResponse: accountDisabledResponse}, nil
+		}
+		return nil, err
+	}
+
+	cookie := http.Cookie{
+		Name:     "refresh_token",
+		Value:    refreshToken.Value,
+		HttpOnly: true,
+		Secure:   true,
+		Path:     "/",
+		SameSite: http.SameSiteNoneMode,
+		Expires:  refreshToken.Expiry.Time(),
+	}
+
+	return api.PostAuthPasskeyLogin200JSONResponse{
+		Headers: api.PostAuthPasskeyLogin200ResponseHeaders{
+			SetCookie: cookie.String(),
+		},
+		Body: api.AuthTokens{
+			AccessToken:  &accessToken.Value,
+			RefreshToken: &refreshToken.Value,
+		},
+	}, nil
+}
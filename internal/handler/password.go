@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"math"
+	"study-planner-api/internal/api"
+	"study-planner-api/internal/auth"
+	"study-planner-api/internal/utils"
+	"study-planner-api/internal/validator/passwordpolicy"
+)
+
+// Gets the violations of the password policy, when the error is one
+func passwordViolationsOf(err error) (*[]api.PasswordViolation, bool) {
+	var policyErr *passwordpolicy.Error
+	if !errors.As(err, &policyErr) {
+		return nil, false
+	}
+
+	violations := make([]api.PasswordViolation, len(policyErr.Violations))
+	for i, v := range policyErr.Violations {
+		violations[i] = api.PasswordViolation(v)
+	}
+
+	return &violations, true
+}
+
+// PostProfilePassword implements api.StrictServerInterface.
+func (s *Handler) PostProfilePassword(ctx context.Context, request api.PostProfilePasswordRequestObject) (api.PostProfilePasswordResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	err := auth.ChangePassword(
+		authInfo.ID,
+		authInfo.SessionID,
+		request.Body.CurrentPassword,
+		request.Body.NewPassword,
+		api.ClientOfRequest(ctx),
+	)
+	if err != nil {
+		if violations, ok := passwordViolationsOf(err); ok {
+			return api.PostProfilePassword400JSONResponse{
+				Type:       utils.Ptr(api.WeakPassword),
+				Message:    utils.Ptr("Password does not follow the policy"),
+				Violations: violations,
+			}, nil
+		}
+
+		var tooManyAttempts *auth.TooManyAttemptsError
+		switch {
+		case errors.As(err, &tooManyAttempts):
+			return api.PostProfilePassword429JSONResponse{
+				TooManyRequestsJSONResponse: api.TooManyRequestsJSONResponse{
+					Headers: api.TooManyRequestsResponseHeaders{
+						RetryAfter: int(math.Ceil(tooManyAttempts.RetryAfter.Seconds())),
+					},
+					Body: api.DefaultResponse{
+						Message: utils.Ptr("Too many failed password attempts"),
+					},
+				},
+			}, nil
+		case errors.Is(err, auth.ErrIncorrectPassword):
+			return api.PostProfilePassword400JSONResponse{
+				Type:    utils.Ptr(api.IncorrectPassword),
+				Message: utils.Ptr("Incorrect current password"),
+			}, nil
+		case errors.Is(err, auth.ErrUserHasNoPassword):
+			return api.PostProfilePassword400JSONResponse{
+				Type:    utils.Ptr(api.NoPassword),
+				Message: utils.Ptr("Account has no password, reset it to set one"),
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.PostProfilePassword200Response{}, nil
+}
@@ -6,8 +6,6 @@ import (
 	"study-planner-api/internal/api"
 	"study-planner-api/internal/auth"
 	"study-planner-api/internal/utils"
-
-	"github.com/rs/zerolog/log"
 )
 
 func (s *Handler) PostAuthPasswordReset(
@@ -19,7 +17,7 @@ func (s *Handler) PostAuthPasswordReset(
 		return api.PostAuthPasswordReset400Response{}, err
 	}
 
-	err := auth.SendPasswordResetEmail(email)
+	err := auth.SendPasswordResetEmail(email, api.ClientOfRequest(ctx))
 	if err != nil {
 		switch {
 		case errors.Is(err, auth.ErrUnknownEmail):
@@ -41,13 +39,16 @@ func (s *Handler) PostAuthPasswordResetConfirm(
 	token := request.Body.Token
 	password := request.Body.NewPassword
 
-	if err := s.Validate.Var(password, "required,default-password"); err != nil {
-		log.Error().Err(err).Msg("Password validation failed")
-		return api.PostAuthPasswordResetConfirm400Response{}, nil
-	}
-
-	err := auth.ResetPassword(userId, token, password)
+	err := auth.ResetPassword(userId, token, password, api.ClientOfRequest(ctx))
 	if err != nil {
+		if violations, ok := passwordViolationsOf(err); ok {
+			return api.PostAuthPasswordResetConfirm400JSONResponse{
+				Type:       utils.Ptr(api.WeakPassword),
+				Message:    utils.Ptr("Password does not follow the policy"),
+				Violations: violations,
+			}, nil
+		}
+
 		switch {
 		case errors.Is(err, auth.ErrInvalidToken):
 			return api.PostAuthPasswordResetConfirm403JSONResponse{
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"study-planner-api/internal/api"
+	"study-planner-api/internal/audit"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/utils"
+
+	"github.com/rs/zerolog/log"
+)
+
+func toApiSecurityEvent(event model.AuditEvent) api.SecurityEvent {
+	var details *map[string]any
+	if event.Details != nil {
+		var parsed map[string]any
+		err := json.Unmarshal([]byte(*event.Details), &parsed)
+		if err != nil {
+			log.Error().Err(err).Int32("audit_event_id", event.ID).Msg("invalid audit event details")
+		} else {
+			details = &parsed
+		}
+	}
+
+	return api.SecurityEvent{
+		ID:        event.ID,
+		UserId:    event.UserID,
+		Type:      event.EventType,
+		Details:   details,
+		IpAddress: event.IPAddress,
+		UserAgent: event.UserAgent,
+		CreatedAt: event.CreatedAt,
+	}
+}
+
+func eventTypesFromParam(param *api.SecurityEventTypeParam) []audit.EventType {
+	if param == nil {
+		return nil
+	}
+
+	types := make([]audit.EventType, len(*param))
+	for i, t := range *param {
+		types[i] = audit.EventType(t)
+	}
+
+	return types
+}
+
+func toApiSecurityEvents(events []model.AuditEvent) *[]api.SecurityEvent {
+	apiEvents := make([]api.SecurityEvent, len(events))
+	for i, event := range events {
+		apiEvents[i] = toApiSecurityEvent(event)
+	}
+
+	return &apiEvents
+}
+
+// GetProfileSecurityEvents implements api.StrictServerInterface.
+func (s *Handler) GetProfileSecurityEvents(ctx context.Context, request api.GetProfileSecurityEventsRequestObject) (api.GetProfileSecurityEventsResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	pagination := paginationFromParams(
+		request.Params.Page,
+		request.Params.Limit,
+		request.Params.Cursor,
+		request.Params.IncludeTotal,
+	)
+
+	events, err := audit.List(audit.Filter{
+		UserID: &authInfo.ID,
+		Types:  eventTypesFromParam(request.Params.Type),
+	}, &pagination)
+	if err != nil {
+		if errors.Is(err, audit.ErrInvalidCursor) {
+			return api.GetProfileSecurityEvents400JSONResponse{Message: utils.Ptr("Invalid cursor")}, nil
+		}
+		return nil, err
+	}
+
+	return api.GetProfileSecurityEvents200JSONResponse{
+		Data:       toApiSecurityEvents(events),
+		Pagination: toApiPagination(pagination),
+	}, nil
+}
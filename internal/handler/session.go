@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"study-planner-api/internal/api"
+	"study-planner-api/internal/auth"
+	"study-planner-api/internal/auth/token"
+	"study-planner-api/internal/utils"
+
+	"github.com/rs/zerolog/log"
+)
+
+// GetSessions implements api.StrictServerInterface.
+func (s *Handler) GetSessions(ctx context.Context, request api.GetSessionsRequestObject) (api.GetSessionsResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	sessions, err := auth.GetSessions(authInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiSessions := make([]api.Session, len(sessions))
+	for i, session := range sessions {
+		apiSessions[i] = api.Session{
+			Id:         &session.ID,
+			DeviceName: session.DeviceName,
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+			Current:    utils.Ptr(session.FamilyID == authInfo.SessionID),
+		}
+	}
+
+	return api.GetSessions200JSONResponse(apiSessions), nil
+}
+
+// DeleteSessionsId implements api.StrictServerInterface.
+func (s *Handler) DeleteSessionsId(ctx context.Context, request api.DeleteSessionsIdRequestObject) (api.DeleteSessionsIdResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	err := auth.RemoveSessionOfUser(request.Id, authInfo.ID, api.ClientOfRequest(ctx))
+	if err != nil {
+		if errors.Is(err, auth.ErrSessionNotFound) {
+			return api.DeleteSessionsId404JSONResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return api.DeleteSessionsId204Response{}, nil
+}
+
+// PostSessionsLogoutOthers implements api.StrictServerInterface.
+func (s *Handler) PostSessionsLogoutOthers(ctx context.Context, request api.PostSessionsLogoutOthersRequestObject) (api.PostSessionsLogoutOthersResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	var refreshToken string
+	if request.Body != nil && request.Body.RefreshToken != nil {
+		refreshToken = *request.Body.RefreshToken
+	} else if request.Params.RefreshToken != nil {
+		refreshToken = *request.Params.RefreshToken
+	} else {
+		log.Debug().Msg("no refresh token provided")
+		return api.PostSessionsLogoutOthers403Response{}, nil
+	}
+
+	info, _, err := token.ValidateRefreshToken(refreshToken)
+	if err != nil || info.UserID != authInfo.ID {
+		return api.PostSessionsLogoutOthers403Response{}, nil
+	}
+
+	revoked, err := auth.RemoveOtherSessions(info, refreshToken, api.ClientOfRequest(ctx))
+	if err != nil {
+		if errors.Is(err, auth.ErrMaliciousRefreshToken) {
+			return api.PostSessionsLogoutOthers403Response{}, nil
+		}
+		return nil, err
+	}
+
+	return api.PostSessionsLogoutOthers200JSONResponse{
+		Revoked: utils.Ptr(int(revoked)),
+	}, nil
+}
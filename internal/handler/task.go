@@ -6,13 +6,12 @@ import (
 	"study-planner-api/internal/api"
 	"study-planner-api/internal/model"
 	"study-planner-api/internal/task"
+	"study-planner-api/internal/utils"
 )
 
 const (
-	TaskSortByDefault        = task.SortFieldCreatedAt
-	TaskSortOrderDefault     = task.SortOrderDesc
-	TaskPageDefault      int = 1
-	TaskLimitDefault     int = 10
+	TaskSortByDefault    = task.SortFieldCreatedAt
+	TaskSortOrderDefault = task.SortOrderDesc
 )
 
 func (s *Handler) GetTasks(ctx context.Context, request api.GetTasksRequestObject) (api.GetTasksResponseObject, error) {
@@ -21,18 +20,12 @@ func (s *Handler) GetTasks(ctx context.Context, request api.GetTasksRequestObjec
 	criteria := task.GetCriteria{
 		UserID: authInfo.ID,
 		Search: request.Params.Search,
-	}
-
-	if request.Params.Page != nil {
-		criteria.Pagination.Page = *request.Params.Page
-	} else {
-		criteria.Pagination.Page = TaskPageDefault
-	}
-
-	if request.Params.Limit != nil {
-		criteria.Pagination.Limit = *request.Params.Limit
-	} else {
-		criteria.Pagination.Limit = TaskLimitDefault
+		Pagination: paginationFromParams(
+			request.Params.Page,
+			request.Params.Limit,
+			request.Params.Cursor,
+			request.Params.IncludeTotal,
+		),
 	}
 
 	if request.Params.Status != nil {
@@ -44,6 +37,15 @@ func (s *Handler) GetTasks(ctx context.Context, request api.GetTasksRequestObjec
 		criteria.Status = &status
 	}
 
+	if request.Params.ExcludeStatus != nil {
+		status, err := task.StatusFromString(*request.Params.ExcludeStatus)
+		if err != nil {
+			return api.GetTasks400Response{}, nil
+		}
+
+		criteria.ExcludeStatus = &status
+	}
+
 	if request.Params.Priority != nil {
 		priority, err := task.PriorityFromString(*request.Params.Priority)
 		if err != nil {
@@ -68,6 +70,8 @@ func (s *Handler) GetTasks(ctx context.Context, request api.GetTasksRequestObjec
 		}
 
 		criteria.SortType.Field = sortBy
+	} else if criteria.Search != nil {
+		criteria.SortType.Field = task.SortFieldRelevance
 	} else {
 		criteria.SortType.Field = TaskSortByDefault
 	}
@@ -85,37 +89,70 @@ func (s *Handler) GetTasks(ctx context.Context, request api.GetTasksRequestObjec
 
 	tasks, err := task.GetTasks(&criteria)
 	if err != nil {
+		if errors.Is(err, task.ErrInvalidCursor) {
+			return api.GetTasks400Response{}, nil
+		}
 		return nil, err
 	}
 
 	apiTasks := make([]api.Task, len(tasks))
 	for i, t := range tasks {
-		apiTasks[i] = api.Task{
-			Id:            &t.ID,
-			Name:          &t.Name,
-			Description:   t.Description,
-			StartTime:     t.StartTime,
-			EndTime:       t.EndTime,
-			Status:        &t.Status,
-			UserId:        t.UserID,
-			CreatedAt:     t.CreatedAt,
-			UpdatedAt:     t.UpdatedAt,
-			EstimatedTime: t.EstimatedTime,
-			Priority:      &t.Priority,
-		}
+		apiTasks[i] = toApiTask(t.Task)
+		apiTasks[i].Snippet = t.Snippet
 	}
 
 	return api.GetTasks200JSONResponse{
-		Data: &apiTasks,
-		Pagination: &api.PaginationResponse{
-			Total:      &criteria.Pagination.Total,
-			Page:       &criteria.Pagination.Page,
-			Limit:      &criteria.Pagination.Limit,
-			TotalPages: &criteria.Pagination.TotalPages,
-		},
+		Data:       &apiTasks,
+		Pagination: toApiPagination(criteria.Pagination),
+	}, nil
+}
+
+// GetTasksTrash implements api.StrictServerInterface.
+func (s *Handler) GetTasksTrash(ctx context.Context, request api.GetTasksTrashRequestObject) (api.GetTasksTrashResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	pagination := paginationFromParams(
+		request.Params.Page,
+		request.Params.Limit,
+		request.Params.Cursor,
+		request.Params.IncludeTotal,
+	)
+
+	tasks, err := task.GetTrashedTasks(authInfo.ID, &pagination)
+	if err != nil {
+		if errors.Is(err, task.ErrInvalidCursor) {
+			return api.GetTasksTrash400Response{}, nil
+		}
+		return nil, err
+	}
+
+	apiTasks := make([]api.Task, len(tasks))
+	for i, t := range tasks {
+		apiTasks[i] = toApiTask(t)
+	}
+
+	return api.GetTasksTrash200JSONResponse{
+		Data:       &apiTasks,
+		Pagination: toApiPagination(pagination),
 	}, nil
 }
 
+// PostTasksIdRestore implements api.StrictServerInterface.
+func (s *Handler) PostTasksIdRestore(ctx context.Context, request api.PostTasksIdRestoreRequestObject) (api.PostTasksIdRestoreResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	err := task.RestoreTaskOfUser(request.Id, authInfo.ID)
+	if err != nil {
+		if errors.Is(err, task.ErrTaskNotFound) {
+			return api.PostTasksIdRestore404JSONResponse{}, nil
+		} else {
+			return nil, err
+		}
+	}
+
+	return api.PostTasksIdRestore200Response{}, nil
+}
+
 // PostTasks implements api.StrictServerInterface.
 func (s *Handler) PostTasks(ctx context.Context, request api.PostTasksRequestObject) (api.PostTasksResponseObject, error) {
 	authInfo := api.AuthInfoOfRequest(ctx)
@@ -155,32 +192,8 @@ func (s *Handler) PostTasks(ctx context.Context, request api.PostTasksRequestObj
 func (s *Handler) PutTasksId(ctx context.Context, request api.PutTasksIdRequestObject) (api.PutTasksIdResponseObject, error) {
 	authInfo := api.AuthInfoOfRequest(ctx)
 
-	taskToUpdate := model.Task{
-		ID:     request.Id,
-		UserID: &authInfo.ID,
-	}
-
-	if request.Body.Name != nil {
-		taskToUpdate.Name = *request.Body.Name
-	}
-	if request.Body.Description != nil {
-		taskToUpdate.Description = request.Body.Description
-	}
-	if request.Body.Priority != nil {
-		taskToUpdate.Priority = *request.Body.Priority
-	}
-	if request.Body.EstimatedTime != nil {
-		taskToUpdate.EstimatedTime = request.Body.EstimatedTime
-	}
-	if request.Body.Status != nil {
-		taskToUpdate.Status = *request.Body.Status
-	}
-	if request.Body.StartTime != nil {
-		taskToUpdate.StartTime = request.Body.StartTime
-	}
-	if request.Body.EndTime != nil {
-		taskToUpdate.EndTime = request.Body.EndTime
-	}
+	taskToUpdate := taskFromUpdateRequest(request.Id, request.Body)
+	taskToUpdate.UserID = &authInfo.ID
 
 	err := task.UpdateTask(taskToUpdate)
 	if err != nil {
@@ -209,3 +222,135 @@ func (s *Handler) DeleteTasksId(ctx context.Context, request api.DeleteTasksIdRe
 
 	return api.DeleteTasksId204Response{}, nil
 }
+
+// PostTasksBatch implements api.StrictServerInterface.
+func (s *Handler) PostTasksBatch(ctx context.Context, request api.PostTasksBatchRequestObject) (api.PostTasksBatchResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	var ops []task.BatchOperation
+	if request.Body.Operations != nil {
+		ops = make([]task.BatchOperation, len(*request.Body.Operations))
+		for i, op := range *request.Body.Operations {
+			opType, err := task.BatchOperationTypeFromString(string(op.Op))
+			if err != nil {
+				return api.PostTasksBatch400Response{}, nil
+			}
+			if opType != task.BatchOperationCreate && op.Id == nil {
+				return api.PostTasksBatch400Response{}, nil
+			}
+			if opType != task.BatchOperationDelete && op.Task == nil {
+				return api.PostTasksBatch400Response{}, nil
+			}
+
+			var id int32
+			if op.Id != nil {
+				id = *op.Id
+			}
+
+			ops[i] = task.BatchOperation{Type: opType}
+			if op.Task != nil {
+				ops[i].Task = taskFromUpdateRequest(id, op.Task)
+			} else {
+				ops[i].Task.ID = id
+			}
+		}
+	}
+
+	var bulk *task.BulkUpdate
+	if request.Body.BulkUpdate != nil {
+		bulk = &task.BulkUpdate{
+			IDs:     request.Body.BulkUpdate.Ids,
+			Changes: taskFromUpdateRequest(0, &request.Body.BulkUpdate.Changes),
+		}
+	}
+
+	if len(ops) == 0 && bulk == nil {
+		return api.PostTasksBatch400Response{}, nil
+	}
+
+	results, err := task.ExecuteBatch(authInfo.ID, ops, bulk)
+	if err != nil && !errors.Is(err, task.ErrBatchFailed) {
+		return nil, err
+	}
+	failed := err != nil
+
+	apiResults := make([]api.BatchTaskResult, len(results))
+	for i, res := range results {
+		apiResults[i] = api.BatchTaskResult{
+			Index: utils.Ptr(i),
+			Op:    utils.Ptr(api.BatchTaskOperationType(res.Type)),
+			Id:    &res.TaskID,
+		}
+
+		switch {
+		case res.Err != nil:
+			apiResults[i].Status = utils.Ptr(api.Failed)
+			apiResults[i].Error = utils.Ptr(res.Err.Error())
+		case failed:
+			apiResults[i].Status = utils.Ptr(api.RolledBack)
+		default:
+			apiResults[i].Status = utils.Ptr(api.Succeeded)
+		}
+
+		// Created tasks do not exist anymore after a rollback
+		if failed && res.Type == task.BatchOperationCreate {
+			apiResults[i].Id = nil
+		}
+	}
+
+	if failed {
+		return api.PostTasksBatch409JSONResponse{Results: &apiResults}, nil
+	}
+
+	return api.PostTasksBatch200JSONResponse{Results: &apiResults}, nil
+}
+
+// Converts the fields present in the request to a task, absent fields are left zero.
+func taskFromUpdateRequest(id int32, body *api.UpdateTaskRequest) model.Task {
+	t := model.Task{ID: id}
+
+	if body.Name != nil {
+		t.Name = *body.Name
+	}
+	if body.Description != nil {
+		t.Description = body.Description
+	}
+	if body.Priority != nil {
+		t.Priority = *body.Priority
+	}
+	if body.EstimatedTime != nil {
+		t.EstimatedTime = body.EstimatedTime
+	}
+	if body.Status != nil {
+		t.Status = *body.Status
+	}
+	if body.StartTime != nil {
+		t.StartTime = body.StartTime
+	}
+	if body.EndTime != nil {
+		t.EndTime = body.EndTime
+	}
+
+	return t
+}
+
+func toApiTask(t model.Task) api.Task {
+	apiTask := api.Task{
+		Id:            &t.ID,
+		Name:          &t.Name,
+		Description:   t.Description,
+		StartTime:     t.StartTime,
+		EndTime:       t.EndTime,
+		Status:        &t.Status,
+		UserId:        t.UserID,
+		CreatedAt:     t.CreatedAt,
+		UpdatedAt:     t.UpdatedAt,
+		EstimatedTime: t.EstimatedTime,
+		Priority:      &t.Priority,
+	}
+	if t.DeletedAt.Valid {
+		apiTask.DeletedAt = &t.DeletedAt.Time
+	}
+
+	return apiTask
+}
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"study-planner-api/internal/api"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/task"
+	"study-planner-api/internal/taskview"
+	"study-planner-api/internal/utils"
+)
+
+func taskViewFromRequest(id int32, userID int32, req *api.TaskViewRequest) model.TaskView {
+	return model.TaskView{
+		ID:            id,
+		UserID:        userID,
+		Name:          req.Name,
+		Status:        req.Status,
+		ExcludeStatus: req.ExcludeStatus,
+		Priority:      req.Priority,
+		Search:        req.Search,
+		StartDate:     req.StartDate,
+		EndDate:       req.EndDate,
+		SortBy:        req.SortBy,
+		SortOrder:     req.SortOrder,
+	}
+}
+
+func toApiTaskView(view model.TaskView) api.TaskView {
+	return api.TaskView{
+		Id:            &view.ID,
+		Name:          view.Name,
+		Status:        view.Status,
+		ExcludeStatus: view.ExcludeStatus,
+		Priority:      view.Priority,
+		Search:        view.Search,
+		StartDate:     view.StartDate,
+		EndDate:       view.EndDate,
+		SortBy:        view.SortBy,
+		SortOrder:     view.SortOrder,
+		CreatedAt:     view.CreatedAt,
+		UpdatedAt:     view.UpdatedAt,
+	}
+}
+
+// GetTaskViews implements api.StrictServerInterface.
+func (s *Handler) GetTaskViews(ctx context.Context, request api.GetTaskViewsRequestObject) (api.GetTaskViewsResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	views, err := taskview.GetViews(authInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiViews := make([]api.TaskView, len(views))
+	for i, view := range views {
+		apiViews[i] = toApiTaskView(view)
+	}
+
+	return api.GetTaskViews200JSONResponse(apiViews), nil
+}
+
+// PostTaskViews implements api.StrictServerInterface.
+func (s *Handler) PostTaskViews(ctx context.Context, request api.PostTaskViewsRequestObject) (api.PostTaskViewsResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	view, err := taskview.CreateView(taskViewFromRequest(0, authInfo.ID, request.Body))
+	if err != nil {
+		if errors.Is(err, taskview.ErrInvalidView) {
+			return api.PostTaskViews400JSONResponse{Message: utils.Ptr(err.Error())}, nil
+		}
+		if errors.Is(err, taskview.ErrViewNameTaken) {
+			return api.PostTaskViews409JSONResponse{Message: utils.Ptr(err.Error())}, nil
+		}
+		return nil, err
+	}
+
+	return api.PostTaskViews201JSONResponse(toApiTaskView(*view)), nil
+}
+
+// GetTaskViewsId implements api.StrictServerInterface.
+func (s *Handler) GetTaskViewsId(ctx context.Context, request api.GetTaskViewsIdRequestObject) (api.GetTaskViewsIdResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	view, err := taskview.GetViewOfUser(request.Id, authInfo.ID)
+	if err != nil {
+		if errors.Is(err, taskview.ErrViewNotFound) {
+			return api.GetTaskViewsId404JSONResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return api.GetTaskViewsId200JSONResponse(toApiTaskView(*view)), nil
+}
+
+// PutTaskViewsId implements api.StrictServerInterface.
+func (s *Handler) PutTaskViewsId(ctx context.Context, request api.PutTaskViewsIdRequestObject) (api.PutTaskViewsIdResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	err := taskview.UpdateView(taskViewFromRequest(request.Id, authInfo.ID, request.Body))
+	if err != nil {
+		if errors.Is(err, taskview.ErrInvalidView) {
+			return api.PutTaskViewsId400JSONResponse{Message: utils.Ptr(err.Error())}, nil
+		}
+		if errors.Is(err, taskview.ErrViewNotFound) {
+			return api.PutTaskViewsId404JSONResponse{}, nil
+		}
+		if errors.Is(err, taskview.ErrViewNameTaken) {
+			return api.PutTaskViewsId409JSONResponse{Message: utils.Ptr(err.Error())}, nil
+		}
+		return nil, err
+	}
+
+	return api.PutTaskViewsId200Response{}, nil
+}
+
+// DeleteTaskViewsId implements api.StrictServerInterface.
+func (s *Handler) DeleteTaskViewsId(ctx context.Context, request api.DeleteTaskViewsIdRequestObject) (api.DeleteTaskViewsIdResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	err := taskview.DeleteViewOfUser(request.Id, authInfo.ID)
+	if err != nil {
+		if errors.Is(err, taskview.ErrViewNotFound) {
+			return api.DeleteTaskViewsId404JSONResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return api.DeleteTaskViewsId204Response{}, nil
+}
+
+// GetTaskViewsIdTasks implements api.StrictServerInterface.
+func (s *Handler) GetTaskViewsIdTasks(ctx context.Context, request api.GetTaskViewsIdTasksRequestObject) (api.GetTaskViewsIdTasksResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	pagination := paginationFromParams(
+		request.Params.Page,
+		request.Params.Limit,
+		request.Params.Cursor,
+		request.Params.IncludeTotal,
+	)
+
+	tasks, err := taskview.GetViewTasks(request.Id, authInfo.ID, &pagination)
+	if err != nil {
+		if errors.Is(err, taskview.ErrViewNotFound) {
+			return api.GetTaskViewsIdTasks404JSONResponse{}, nil
+		}
+		if errors.Is(err, task.ErrInvalidCursor) {
+			return api.GetTaskViewsIdTasks400Response{}, nil
+		}
+		return nil, err
+	}
+
+	apiTasks := make([]api.Task, len(tasks))
+	for i, t := range tasks {
+		apiTasks[i] = toApiTask(t.Task)
+		apiTasks[i].Snippet = t.Snippet
+	}
+
+	return api.GetTaskViewsIdTasks200JSONResponse{
+		Data:       &apiTasks,
+		Pagination: toApiPagination(pagination),
+	}, nil
+}
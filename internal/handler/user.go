@@ -2,24 +2,159 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"math"
 	"study-planner-api/internal/api"
+	"study-planner-api/internal/auth"
+	"study-planner-api/internal/auth/provider"
+	"study-planner-api/internal/auth/role"
+	"study-planner-api/internal/model"
 	"study-planner-api/internal/user"
+	"study-planner-api/internal/user/profile"
+	"study-planner-api/internal/utils"
 )
 
+func toApiUser(userInfo user.UserInfo, userProfile profile.Profile, identities []model.ExternalIdentity) api.User {
+	apiIdentities := make([]api.LinkedIdentity, len(identities))
+	for i, identity := range identities {
+		apiIdentities[i] = toApiLinkedIdentity(identity)
+	}
+
+	return api.User{
+		ID:                   &userInfo.ID,
+		Email:                &userInfo.Email,
+		CreatedAt:            &userInfo.CreatedAt,
+		IsActivated:          &userInfo.IsActivated,
+		Role:                 utils.Ptr(api.Role(role.Parse(userInfo.Role))),
+		DisplayName:          userProfile.DisplayName,
+		AvatarUrl:            userProfile.AvatarUrl,
+		Locale:               userProfile.Locale,
+		Timezone:             userProfile.Timezone,
+		WeekStartDay:         utils.Ptr(int32(userProfile.WeekStartDay)),
+		DefaultFocusDuration: userProfile.DefaultFocusDuration,
+		DefaultBreakDuration: userProfile.DefaultBreakDuration,
+		Identities:           &apiIdentities,
+	}
+}
+
+// Gets the user with its profile, after it is read or updated
+func getApiUser(userID int32, userProfile *profile.Profile) (api.User, error) {
+	userInfo, err := user.GetUserInfo(userID)
+	if err != nil {
+		return api.User{}, err
+	}
+
+	if userProfile == nil {
+		p, err := profile.Get(userID)
+		if err != nil {
+			return api.User{}, err
+		}
+		userProfile = &p
+	}
+
+	identities, err := provider.GetIdentities(userID)
+	if err != nil {
+		return api.User{}, err
+	}
+
+	return toApiUser(userInfo, *userProfile, identities), nil
+}
+
 func (s *Handler) GetProfile(
 	ctx context.Context,
 	request api.GetProfileRequestObject,
 ) (api.GetProfileResponseObject, error) {
 	authInfo := api.AuthInfoOfRequest(ctx)
 
-	userInfo, err := user.GetUserInfo(authInfo.ID)
+	apiUser, err := getApiUser(authInfo.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetProfile200JSONResponse(apiUser), nil
+}
+
+func (s *Handler) PatchProfile(
+	ctx context.Context,
+	request api.PatchProfileRequestObject,
+) (api.PatchProfileResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	userProfile, err := profile.Apply(authInfo.ID, profile.Update{
+		DisplayName:          request.Body.DisplayName,
+		AvatarUrl:            request.Body.AvatarUrl,
+		Locale:               request.Body.Locale,
+		Timezone:             request.Body.Timezone,
+		WeekStartDay:         request.Body.WeekStartDay,
+		DefaultFocusDuration: request.Body.DefaultFocusDuration,
+		DefaultBreakDuration: request.Body.DefaultBreakDuration,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, profile.ErrInvalidDisplayName):
+			return api.PatchProfile400JSONResponse{Message: utils.Ptr("Invalid display name")}, nil
+		case errors.Is(err, profile.ErrInvalidAvatarUrl):
+			return api.PatchProfile400JSONResponse{Message: utils.Ptr("Avatar URL must be an absolute http(s) URL")}, nil
+		case errors.Is(err, profile.ErrInvalidLocale):
+			return api.PatchProfile400JSONResponse{Message: utils.Ptr("Locale must be a BCP 47 language tag")}, nil
+		case errors.Is(err, profile.ErrInvalidTimezone):
+			return api.PatchProfile400JSONResponse{Message: utils.Ptr("Timezone must be an IANA time zone")}, nil
+		case errors.Is(err, profile.ErrInvalidWeekStartDay):
+			return api.PatchProfile400JSONResponse{Message: utils.Ptr("Week start day must be from 0 to 6")}, nil
+		case errors.Is(err, profile.ErrInvalidDuration):
+			return api.PatchProfile400JSONResponse{Message: utils.Ptr("Durations must be from 60 to 14400 seconds")}, nil
+		}
+		return nil, err
+	}
+
+	apiUser, err := getApiUser(authInfo.ID, &userProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PatchProfile200JSONResponse(apiUser), nil
+}
+
+func (s *Handler) DeleteProfile(
+	ctx context.Context,
+	request api.DeleteProfileRequestObject,
+) (api.DeleteProfileResponseObject, error) {
+	authInfo := api.AuthInfoOfRequest(ctx)
+
+	var password *string
+	if request.Body != nil {
+		password = request.Body.Password
+	}
+
+	deletionTime, err := auth.ScheduleAccountDeletion(authInfo.ID, authInfo.SessionID, password, api.ClientOfRequest(ctx))
 	if err != nil {
+		var tooManyAttempts *auth.TooManyAttemptsError
+		switch {
+		case errors.As(err, &tooManyAttempts):
+			return api.DeleteProfile429JSONResponse{
+				TooManyRequestsJSONResponse: api.TooManyRequestsJSONResponse{
+					Headers: api.TooManyRequestsResponseHeaders{
+						RetryAfter: int(math.Ceil(tooManyAttempts.RetryAfter.Seconds())),
+					},
+					Body: api.DefaultResponse{
+						Message: utils.Ptr("Too many failed password attempts"),
+					},
+				},
+			}, nil
+		case errors.Is(err, auth.ErrIncorrectPassword):
+			return api.DeleteProfile400JSONResponse{
+				Type:    utils.Ptr(api.IncorrectPassword),
+				Message: utils.Ptr("Missing or incorrect password"),
+			}, nil
+		case errors.Is(err, auth.ErrReauthenticationRequired):
+			return api.DeleteProfile401JSONResponse{
+				Message: utils.Ptr("Log in again to delete the account"),
+			}, nil
+		}
 		return nil, err
 	}
 
-	return api.GetProfile200JSONResponse{
-		CreatedAt: &userInfo.CreatedAt,
-		Email:     &userInfo.Email,
-		ID:        &userInfo.ID,
+	return api.DeleteProfile202JSONResponse{
+		DeletionScheduledAt: deletionTime,
 	}, nil
 }
@@ -0,0 +1,85 @@
+package maintenance
+
+import (
+	"study-planner-api/internal/auth"
+	"study-planner-api/internal/ratelimit"
+	"study-planner-api/internal/task"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Runs the periodic clean up jobs.
+// Every job is run even if a previous one failed, the first error is returned.
+func Run() error {
+	var firstErr error
+
+	purgedTasks, err := task.PurgeTrash()
+	if err != nil {
+		log.Error().Err(err).Msg("failed to purge trashed tasks")
+		firstErr = err
+	} else {
+		log.Info().Int64("count", purgedTasks).Msg("purged trashed tasks")
+	}
+
+	purgedBuckets, err := ratelimit.PurgeIdleBuckets()
+	if err != nil {
+		log.Error().Err(err).Msg("failed to purge idle rate limit buckets")
+		if firstErr == nil {
+			firstErr = err
+		}
+	} else {
+		log.Info().Int64("count", purgedBuckets).Msg("purged idle rate limit buckets")
+	}
+
+	purgedThrottles, err := auth.PurgeStaleLoginThrottles()
+	if err != nil {
+		log.Error().Err(err).Msg("failed to purge stale login throttles")
+		if firstErr == nil {
+			firstErr = err
+		}
+	} else {
+		log.Info().Int64("count", purgedThrottles).Msg("purged stale login throttles")
+	}
+
+	purgedCodes, err := auth.PurgeExpiredExchangeCodes()
+	if err != nil {
+		log.Error().Err(err).Msg("failed to purge expired exchange codes")
+		if firstErr == nil {
+			firstErr = err
+		}
+	} else {
+		log.Info().Int64("count", purgedCodes).Msg("purged expired exchange codes")
+	}
+
+	purgedChallenges, err := auth.PurgeExpiredMfaChallenges()
+	if err != nil {
+		log.Error().Err(err).Msg("failed to purge expired mfa challenges")
+		if firstErr == nil {
+			firstErr = err
+		}
+	} else {
+		log.Info().Int64("count", purgedChallenges).Msg("purged expired mfa challenges")
+	}
+
+	purgedPasskeyChallenges, err := auth.PurgeUsedPasskeyChallenges()
+	if err != nil {
+		log.Error().Err(err).Msg("failed to purge used passkey challenges")
+		if firstErr == nil {
+			firstErr = err
+		}
+	} else {
+		log.Info().Int64("count", purgedPasskeyChallenges).Msg("purged used passkey challenges")
+	}
+
+	erasedAccounts, err := auth.PurgeDeletedAccounts()
+	if err != nil {
+		log.Error().Err(err).Msg("failed to erase deleted accounts")
+		if firstErr == nil {
+			firstErr = err
+		}
+	} else {
+		log.Info().Int64("count", erasedAccounts).Msg("erased deleted accounts")
+	}
+
+	return firstErr
+}
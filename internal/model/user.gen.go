@@ -12,13 +12,25 @@ const TableNameUser = "user"
 
 // User mapped from table <user>
 type User struct {
-	ID          int32      `gorm:"column:id;primaryKey" json:"id"`
-	Email       *string    `gorm:"column:email" json:"email"`
-	Password    *string    `gorm:"column:password" json:"password"`
-	GoogleID    *string    `gorm:"column:google_id" json:"google_id"`
-	CreatedAt   *time.Time `gorm:"column:created_at" json:"created_at"`
-	UpdatedAt   *time.Time `gorm:"column:updated_at" json:"updated_at"`
-	IsActivated bool       `gorm:"column:is_activated;not null;default:FALSE" json:"is_activated"`
+	ID                   int32      `gorm:"column:id;primaryKey" json:"id"`
+	Email                *string    `gorm:"column:email" json:"email"`
+	Password             *string    `gorm:"column:password" json:"password"`
+	GoogleID             *string    `gorm:"column:google_id" json:"google_id"`
+	CreatedAt            *time.Time `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt            *time.Time `gorm:"column:updated_at" json:"updated_at"`
+	IsActivated          bool       `gorm:"column:is_activated;not null;default:FALSE" json:"is_activated"`
+	TokenVersion         int32      `gorm:"column:token_version;not null" json:"token_version"`
+	PendingEmail         *string    `gorm:"column:pending_email" json:"pending_email"`
+	DeletionScheduledAt  *time.Time `gorm:"column:deletion_scheduled_at" json:"deletion_scheduled_at"`
+	DisplayName          *string    `gorm:"column:display_name" json:"display_name"`
+	AvatarURL            *string    `gorm:"column:avatar_url" json:"avatar_url"`
+	Locale               *string    `gorm:"column:locale" json:"locale"`
+	Timezone             *string    `gorm:"column:timezone" json:"timezone"`
+	WeekStartDay         int32      `gorm:"column:week_start_day;not null;default:1" json:"week_start_day"`
+	DefaultFocusDuration *int32     `gorm:"column:default_focus_duration" json:"default_focus_duration"`
+	DefaultBreakDuration *int32     `gorm:"column:default_break_duration" json:"default_break_duration"`
+	Role                 string     `gorm:"column:role;not null;default:user" json:"role"`
+	DisabledAt           *time.Time `gorm:"column:disabled_at" json:"disabled_at"`
 }
 
 // TableName User's table name
@@ -12,11 +12,16 @@ const TableNameUserSession = "user_session"
 
 // UserSession mapped from table <user_session>
 type UserSession struct {
-	ID           int32      `gorm:"column:id;primaryKey" json:"id"`
-	UserID       int32      `gorm:"column:user_id;not null" json:"user_id"`
-	RefreshToken string     `gorm:"column:refresh_token;not null" json:"refresh_token"`
-	ExpiresAt    *time.Time `gorm:"column:expires_at" json:"expires_at"`
-	CreatedAt    *time.Time `gorm:"column:created_at" json:"created_at"`
+	ID               int32      `gorm:"column:id;primaryKey" json:"id"`
+	UserID           int32      `gorm:"column:user_id;not null" json:"user_id"`
+	FamilyID         string     `gorm:"column:family_id;not null" json:"family_id"`
+	RefreshTokenHash string     `gorm:"column:refresh_token_hash;not null" json:"refresh_token_hash"`
+	ExpiresAt        *time.Time `gorm:"column:expires_at" json:"expires_at"`
+	CreatedAt        *time.Time `gorm:"column:created_at" json:"created_at"`
+	DeviceName       *string    `gorm:"column:device_name" json:"device_name"`
+	UserAgent        *string    `gorm:"column:user_agent" json:"user_agent"`
+	IPAddress        *string    `gorm:"column:ip_address" json:"ip_address"`
+	LastUsedAt       *time.Time `gorm:"column:last_used_at" json:"last_used_at"`
 }
 
 // TableName UserSession's table name
@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"errors"
+	db "study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+const (
+	dbStoreMaxAttempts = 3
+	// Longer than the period of any limit, so that purged buckets were full
+	bucketIdleRetention = time.Hour * 24
+)
+
+var ErrBucketContention = errors.New("rate limit bucket updated concurrently too many times")
+
+// Store keeping the buckets in the database, shared by every instance, such as Lambda ones.
+// Buckets are updated optimistically with a version, and retried on conflicts.
+type DBStore struct{}
+
+func NewDBStore() *DBStore {
+	return &DBStore{}
+}
+
+func (s *DBStore) Take(key string, limit Limit, now time.Time) (Result, error) {
+	for range dbStoreMaxAttempts {
+		var row model.RateLimitBucket
+		result := db.Instance().
+			Model(&model.RateLimitBucket{}).
+			Where("key = ?", key).
+			Limit(1).
+			Find(&row)
+		if result.Error != nil {
+			return Result{}, result.Error
+		}
+		found := result.RowsAffected > 0
+
+		var b bucket
+		if found {
+			b = bucket{tokens: row.Tokens, refilledAt: time.UnixMilli(row.RefilledAt)}
+		}
+
+		newBucket, takeResult := take(b, limit, now)
+
+		if !found {
+			result = db.Instance().
+				Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&model.RateLimitBucket{
+					Key:        key,
+					Tokens:     newBucket.tokens,
+					RefilledAt: newBucket.refilledAt.UnixMilli(),
+				})
+		} else {
+			result = db.Instance().
+				Model(&model.RateLimitBucket{}).
+				Where("key = ? AND version = ?", key, row.Version).
+				Updates(map[string]any{
+					"tokens":      newBucket.tokens,
+					"refilled_at": newBucket.refilledAt.UnixMilli(),
+					"version":     row.Version + 1,
+				})
+		}
+		if result.Error != nil {
+			return Result{}, result.Error
+		}
+		if result.RowsAffected > 0 {
+			return takeResult, nil
+		}
+	}
+
+	return Result{}, ErrBucketContention
+}
+
+// Deletes the buckets which have not been used for a day, they are full again anyway.
+// Returns the number of deleted buckets.
+func PurgeIdleBuckets() (int64, error) {
+	result := db.Instance().
+		Where("refilled_at < ?", time.Now().Add(-bucketIdleRetention).UnixMilli()).
+		Delete(&model.RateLimitBucket{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
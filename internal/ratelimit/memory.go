@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type memoryBucket struct {
+	bucket
+	key    string
+	period time.Duration
+}
+
+// Store keeping the buckets in memory, only suited to a single long-running instance.
+// When the store is full, full buckets are evicted, then the least recently used ones if still needed,
+// so that a flood of new keys does not reset the buckets in use.
+// Safe for concurrent use.
+type MemoryStore struct {
+	mu      sync.Mutex
+	maxSize int
+	buckets map[string]*list.Element
+	// Buckets from the most to the least recently used
+	recent *list.List
+}
+
+func NewMemoryStore(maxSize int) *MemoryStore {
+	return &MemoryStore{
+		maxSize: maxSize,
+		buckets: make(map[string]*list.Element),
+		recent:  list.New(),
+	}
+}
+
+func (s *MemoryStore) Take(key string, limit Limit, now time.Time) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, ok := s.buckets[key]
+	if !ok {
+		if len(s.buckets) >= s.maxSize {
+			s.evict(now)
+		}
+
+		element = s.recent.PushFront(&memoryBucket{key: key})
+		s.buckets[key] = element
+	} else {
+		s.recent.MoveToFront(element)
+	}
+
+	b := element.Value.(*memoryBucket)
+
+	var result Result
+	b.bucket, result = take(b.bucket, limit, now)
+	b.period = limit.Period
+
+	return result, nil
+}
+
+// Makes room for one bucket, must be called with the lock held
+func (s *MemoryStore) evict(now time.Time) {
+	for element := s.recent.Front(); element != nil; {
+		next := element.Next()
+		if b := element.Value.(*memoryBucket); !now.Before(b.refilledAt.Add(b.period)) {
+			s.remove(element)
+		}
+		element = next
+	}
+
+	for len(s.buckets) >= s.maxSize {
+		s.remove(s.recent.Back())
+	}
+}
+
+func (s *MemoryStore) remove(element *list.Element) {
+	delete(s.buckets, element.Value.(*memoryBucket).key)
+	s.recent.Remove(element)
+}
@@ -0,0 +1,132 @@
+// Rate limiting of requests with token buckets, kept in a pluggable store.
+package ratelimit
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+type Limit struct {
+	// Requests allowed in a burst, the capacity of the bucket
+	Burst int
+	// Time for an empty bucket to refill completely
+	Period time.Duration
+}
+
+type Result struct {
+	Allowed   bool
+	Remaining int
+	// How long until a token is available, when the request is not allowed
+	RetryAfter time.Duration
+}
+
+type Store interface {
+	// Takes a token from the bucket of the key, refilled according to the limit
+	Take(key string, limit Limit, now time.Time) (Result, error)
+}
+
+type bucket struct {
+	tokens     float64
+	refilledAt time.Time
+}
+
+// Refills the bucket up to "now", then takes a token if one is available.
+// A zero bucket is full.
+func take(b bucket, limit Limit, now time.Time) (bucket, Result) {
+	burst := float64(limit.Burst)
+
+	if b.refilledAt.IsZero() {
+		b = bucket{tokens: burst, refilledAt: now}
+	} else if elapsed := now.Sub(b.refilledAt); elapsed > 0 {
+		b.tokens = math.Min(burst, b.tokens+burst*float64(elapsed)/float64(limit.Period))
+		b.refilledAt = now
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return b, Result{Allowed: true, Remaining: int(b.tokens)}
+	}
+
+	retryAfter := time.Duration((1 - b.tokens) * float64(limit.Period) / burst)
+	return b, Result{Allowed: false, RetryAfter: retryAfter}
+}
+
+// Who a limit applies to
+type Principal int
+
+const (
+	ByIP Principal = iota
+	// Only applies to authenticated requests
+	ByUser
+)
+
+func (p Principal) String() string {
+	if p == ByUser {
+		return "user"
+	}
+	return "ip"
+}
+
+type Rule struct {
+	Method string
+	// Path of the route, as registered in echo, e.g. "/tasks/:id"
+	Path  string
+	By    Principal
+	Limit Limit
+}
+
+type Config struct {
+	Store Store
+	Rules []Rule
+	// Identifies the principal of the request.
+	// Returns false when it cannot, such as a user for an anonymous request, the rule is then skipped.
+	Identify func(c echo.Context, by Principal) (string, bool)
+}
+
+// Rejects the requests exceeding the limit of a rule of their route, with 429 and Retry-After.
+// Must be used after the request is authenticated, for the rules limiting users.
+// Requests are allowed when the store fails, rather than making the API unavailable.
+func Middleware(config Config) echo.MiddlewareFunc {
+	rules := make(map[string][]Rule)
+	for _, rule := range config.Rules {
+		route := rule.Method + " " + rule.Path
+		rules[route] = append(rules[route], rule)
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			route := c.Request().Method + " " + c.Path()
+
+			var retryAfter time.Duration
+			for _, rule := range rules[route] {
+				id, ok := config.Identify(c, rule.By)
+				if !ok {
+					continue
+				}
+
+				key := route + " " + rule.By.String() + ":" + id
+				result, err := config.Store.Take(key, rule.Limit, time.Now())
+				if err != nil {
+					log.Error().Err(err).Str("key", key).Msg("failed to take rate limit token")
+					continue
+				}
+
+				if !result.Allowed {
+					retryAfter = max(retryAfter, result.RetryAfter)
+				}
+			}
+
+			if retryAfter > 0 {
+				c.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+				return c.JSON(http.StatusTooManyRequests, map[string]string{"message": "Too many requests"})
+			}
+
+			return next(c)
+		}
+	}
+}
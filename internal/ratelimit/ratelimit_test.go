@@ -0,0 +1,117 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestTake(t *testing.T) {
+	limit := Limit{Burst: 2, Period: time.Minute}
+	now := time.Now()
+
+	var b bucket
+	var result Result
+	for i := range 2 {
+		b, result = take(b, limit, now)
+		if !result.Allowed || result.Remaining != 1-i {
+			t.Fatalf("take() #%d = %+v, want allowed with %d remaining", i, result, 1-i)
+		}
+	}
+
+	b, result = take(b, limit, now)
+	if result.Allowed || result.RetryAfter != time.Second*30 {
+		t.Fatalf("take() on empty bucket = %+v, want retry after 30s", result)
+	}
+
+	// Half the period refills one token
+	b, result = take(b, limit, now.Add(time.Second*30))
+	if !result.Allowed || result.Remaining != 0 {
+		t.Fatalf("take() after refill = %+v, want allowed", result)
+	}
+
+	// Refills are capped at the burst
+	_, result = take(b, limit, now.Add(time.Hour))
+	if !result.Allowed || result.Remaining != 1 {
+		t.Fatalf("take() after a long time = %+v, want allowed with 1 remaining", result)
+	}
+}
+
+func TestMemoryStoreEviction(t *testing.T) {
+	store := NewMemoryStore(2)
+	limit := Limit{Burst: 1, Period: time.Minute}
+	now := time.Now()
+
+	store.Take("a", limit, now)
+	store.Take("b", limit, now.Add(time.Second*50))
+	// "a" is full again, so it is evicted rather than "b"
+	store.Take("c", limit, now.Add(time.Minute))
+
+	if _, ok := store.buckets["a"]; ok || len(store.buckets) != 2 {
+		t.Fatalf("buckets = %v, want b and c", store.buckets)
+	}
+
+	if result, _ := store.Take("b", limit, now.Add(time.Minute)); result.Allowed {
+		t.Errorf("Take(b) = %+v, want not allowed", result)
+	}
+}
+
+func TestMemoryStoreEvictsLeastRecentlyUsed(t *testing.T) {
+	store := NewMemoryStore(2)
+	limit := Limit{Burst: 2, Period: time.Minute}
+	now := time.Now()
+
+	store.Take("a", limit, now)
+	store.Take("b", limit, now.Add(time.Second))
+	store.Take("a", limit, now.Add(time.Second*2))
+	// No bucket is full, "b" is the least recently used
+	store.Take("c", limit, now.Add(time.Second*3))
+
+	if _, ok := store.buckets["b"]; ok || len(store.buckets) != 2 {
+		t.Fatalf("buckets = %v, want a and c", store.buckets)
+	}
+
+	if result, _ := store.Take("a", limit, now.Add(time.Second*3)); result.Allowed {
+		t.Errorf("Take(a) = %+v, want not allowed", result)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	e := echo.New()
+	e.Use(Middleware(Config{
+		Store: NewMemoryStore(10),
+		Rules: []Rule{{
+			Method: http.MethodPost, Path: "/items/:id", By: ByIP,
+			Limit: Limit{Burst: 1, Period: time.Minute},
+		}},
+		Identify: func(c echo.Context, by Principal) (string, bool) {
+			return c.RealIP(), by == ByIP
+		},
+	}))
+	e.POST("/items/:id", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	e.GET("/items/:id", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+	request := func(method, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec
+	}
+
+	if rec := request(http.MethodPost, "/items/1"); rec.Code != http.StatusOK {
+		t.Fatalf("first request status = %d, want 200", rec.Code)
+	}
+
+	// The limit is per route rather than per URL
+	rec := request(http.MethodPost, "/items/2")
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") != "60" {
+		t.Fatalf("second request = %d with Retry-After %q, want 429 after 60s",
+			rec.Code, rec.Header().Get("Retry-After"))
+	}
+
+	if rec := request(http.MethodGet, "/items/1"); rec.Code != http.StatusOK {
+		t.Errorf("unlimited route status = %d, want 200", rec.Code)
+	}
+}
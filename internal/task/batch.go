@@ -0,0 +1,183 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	db "study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+
+	"gorm.io/gorm"
+)
+
+type BatchOperationType string
+
+const (
+	BatchOperationCreate BatchOperationType = "create"
+	BatchOperationUpdate BatchOperationType = "update"
+	BatchOperationDelete BatchOperationType = "delete"
+)
+
+func BatchOperationTypeFromString(str string) (BatchOperationType, error) {
+	switch str {
+	case string(BatchOperationCreate):
+		return BatchOperationCreate, nil
+	case string(BatchOperationUpdate):
+		return BatchOperationUpdate, nil
+	case string(BatchOperationDelete):
+		return BatchOperationDelete, nil
+	default:
+		return *new(BatchOperationType), fmt.Errorf("invalid batch operation: %s", str)
+	}
+}
+
+var (
+	ErrBatchFailed        = errors.New("batch failed, no changes were applied")
+	ErrMissingTaskFields  = errors.New("name, priority and status are required")
+	ErrInvalidBatchOpType = errors.New("invalid batch operation")
+)
+
+// A single operation of a batch.
+// "Task.ID" is used by update and delete, other fields by create and update.
+type BatchOperation struct {
+	Type BatchOperationType
+	Task model.Task
+}
+
+// Applies the same changes to every listed task.
+type BulkUpdate struct {
+	IDs     []int32
+	Changes model.Task
+}
+
+type BatchResult struct {
+	Type   BatchOperationType
+	TaskID int32
+	Err    error
+}
+
+// Executes all operations of a user in one transaction.
+// Returns one result per operation followed by one result per bulk updated task.
+// If any of them fails, the transaction is rolled back and ErrBatchFailed is returned
+// together with the results, where "Err" tells which operations caused it.
+func ExecuteBatch(userID int32, ops []BatchOperation, bulk *BulkUpdate) ([]BatchResult, error) {
+	var results []BatchResult
+
+	err := db.Instance().Transaction(func(tx *gorm.DB) error {
+		results = make([]BatchResult, 0, len(ops))
+		failed := false
+
+		for _, op := range ops {
+			res, err := executeBatchOperation(tx, userID, op)
+			if err != nil && !isBatchOperationError(err) {
+				return err
+			}
+
+			res.Err = err
+			failed = failed || err != nil
+			results = append(results, res)
+		}
+
+		if bulk != nil {
+			bulkResults, err := bulkUpdateTasksOfUser(tx, userID, *bulk)
+			if err != nil {
+				return err
+			}
+
+			for _, res := range bulkResults {
+				failed = failed || res.Err != nil
+			}
+			results = append(results, bulkResults...)
+		}
+
+		if failed {
+			return ErrBatchFailed
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrBatchFailed) {
+			return results, err
+		}
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func isBatchOperationError(err error) bool {
+	return errors.Is(err, ErrTaskNotFound) ||
+		errors.Is(err, ErrMissingTaskFields) ||
+		errors.Is(err, ErrInvalidBatchOpType)
+}
+
+func executeBatchOperation(tx *gorm.DB, userID int32, op BatchOperation) (BatchResult, error) {
+	res := BatchResult{Type: op.Type, TaskID: op.Task.ID}
+
+	switch op.Type {
+	case BatchOperationCreate:
+		if op.Task.Name == "" || op.Task.Priority == "" || op.Task.Status == "" {
+			return res, ErrMissingTaskFields
+		}
+
+		op.Task.ID = 0
+		op.Task.UserID = &userID
+
+		created, err := createTask(tx, op.Task)
+		if err != nil {
+			return res, err
+		}
+		res.TaskID = created.ID
+
+		return res, nil
+	case BatchOperationUpdate:
+		op.Task.UserID = &userID
+		return res, updateTask(tx, op.Task)
+	case BatchOperationDelete:
+		return res, deleteTaskOfUser(tx, op.Task.ID, userID)
+	default:
+		return res, ErrInvalidBatchOpType
+	}
+}
+
+func bulkUpdateTasksOfUser(tx *gorm.DB, userID int32, bulk BulkUpdate) ([]BatchResult, error) {
+	var ownedIDs []int32
+	result := tx.
+		Model(&model.Task{}).
+		Where("user_id = ? AND id IN ?", userID, bulk.IDs).
+		Pluck("id", &ownedIDs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	owned := make(map[int32]bool, len(ownedIDs))
+	for _, id := range ownedIDs {
+		owned[id] = true
+	}
+
+	results := make([]BatchResult, len(bulk.IDs))
+	for i, id := range bulk.IDs {
+		results[i] = BatchResult{Type: BatchOperationUpdate, TaskID: id}
+		if !owned[id] {
+			results[i].Err = ErrTaskNotFound
+		}
+	}
+
+	if len(ownedIDs) == 0 {
+		return results, nil
+	}
+
+	changes := bulk.Changes
+	changes.ID = 0
+	changes.UserID = nil
+
+	result = tx.
+		Model(&model.Task{}).
+		Where("user_id = ? AND id IN ?", userID, ownedIDs).
+		Updates(&changes)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return results, nil
+}
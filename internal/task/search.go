@@ -0,0 +1,77 @@
+package task
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+)
+
+const (
+	// Markers put around matched terms by the snippet function,
+	// replaced after the snippet is escaped
+	snippetMatchStart = "\x02"
+	snippetMatchEnd   = "\x03"
+
+	snippetMaxTokens = 12
+)
+
+var snippetSelect = fmt.Sprintf(
+	"snippet(task_fts, -1, '%s', '%s', '…', %d)",
+	snippetMatchStart, snippetMatchEnd, snippetMaxTokens,
+)
+
+// Converts a user search term to an FTS5 query.
+// Every word must match, words ending with "*" match as prefixes
+// and words inside double quotes match as a phrase.
+// Everything else is quoted, so the term can never be a malformed query.
+// Returns an empty string if the term has nothing to search for.
+func toFtsQuery(term string) string {
+	var parts []string
+
+	for i, segment := range strings.Split(term, `"`) {
+		// Odd segments are inside quotes, an unterminated quote runs until the end
+		if i%2 == 1 {
+			if words := searchWords(segment); len(words) > 0 {
+				parts = append(parts, quoteFts(strings.Join(words, " ")))
+			}
+			continue
+		}
+
+		for _, field := range strings.Fields(segment) {
+			words := searchWords(field)
+			if len(words) == 0 {
+				continue
+			}
+
+			for _, word := range words {
+				parts = append(parts, quoteFts(word))
+			}
+			if strings.HasSuffix(field, "*") {
+				parts[len(parts)-1] += "*"
+			}
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Splits the text into words made of letters and digits only
+func searchWords(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func quoteFts(text string) string {
+	return `"` + strings.ReplaceAll(text, `"`, `""`) + `"`
+}
+
+// Escapes the snippet returned by the database and highlights the matched terms
+func formatSnippet(snippet string) string {
+	escaped := html.EscapeString(snippet)
+	escaped = strings.ReplaceAll(escaped, snippetMatchStart, "<mark>")
+	escaped = strings.ReplaceAll(escaped, snippetMatchEnd, "</mark>")
+
+	return escaped
+}
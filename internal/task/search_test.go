@@ -0,0 +1,47 @@
+package task
+
+import "testing"
+
+func TestToFtsQuery(t *testing.T) {
+	tests := []struct {
+		term string
+		want string
+	}{
+		{term: "math homework", want: `"math" "homework"`},
+		{term: "calc*", want: `"calc"*`},
+		{term: `"linear algebra" exam`, want: `"linear algebra" "exam"`},
+		{term: `"unterminated phrase`, want: `"unterminated phrase"`},
+		{term: "pre-lab* NEAR(x)", want: `"pre" "lab"* "NEAR" "x"`},
+		{term: "* ** \"\"", want: ""},
+		{term: "ôn tập", want: `"ôn" "tập"`},
+	}
+
+	for _, tt := range tests {
+		if got := toFtsQuery(tt.term); got != tt.want {
+			t.Errorf("toFtsQuery(%q) = %q, want %q", tt.term, got, tt.want)
+		}
+	}
+}
+
+func TestFormatSnippet(t *testing.T) {
+	snippet := "read <b>" + snippetMatchStart + "chapter" + snippetMatchEnd + "</b> 3"
+	want := "read &lt;b&gt;<mark>chapter</mark>&lt;/b&gt; 3"
+
+	if got := formatSnippet(snippet); got != want {
+		t.Errorf("formatSnippet() = %q, want %q", got, want)
+	}
+}
+
+func TestGetTasksEmptySearch(t *testing.T) {
+	for _, term := range []string{"***", `"" -`, " "} {
+		criteria := GetCriteria{UserID: 1, Search: &term}
+
+		tasks, err := GetTasks(&criteria)
+		if err != nil || len(tasks) != 0 {
+			t.Errorf("GetTasks(%q) = %v, %v, want no task", term, tasks, err)
+		}
+		if criteria.Pagination.NextCursor != nil {
+			t.Errorf("GetTasks(%q) has a next page", term)
+		}
+	}
+}
@@ -3,10 +3,12 @@ package task
 import (
 	"errors"
 	"fmt"
+	"os"
+	"strconv"
 	db "study-planner-api/internal/database"
 	"study-planner-api/internal/model"
 	"study-planner-api/internal/utils"
-	"sync"
+	"study-planner-api/internal/utils/cursor"
 	"time"
 
 	"gorm.io/gorm"
@@ -73,6 +75,7 @@ const (
 	SortFieldStartTime SortField = "end_time"
 	SortFieldEndTime   SortField = "start_time"
 	SortFieldPriority  SortField = "priority"
+	SortFieldRelevance SortField = "relevance" // only applies when searching
 )
 
 func SortFieldFromString(str string) (SortField, error) {
@@ -85,6 +88,8 @@ func SortFieldFromString(str string) (SortField, error) {
 		return SortFieldEndTime, nil
 	case string(SortFieldPriority):
 		return SortFieldPriority, nil
+	case string(SortFieldRelevance):
+		return SortFieldRelevance, nil
 	default:
 		return *new(SortField), fmt.Errorf("invalid sort field: %s", str)
 	}
@@ -108,12 +113,28 @@ func SortOrderFromString(str string) (SortOrder, error) {
 	}
 }
 
+func getTrashRetention() time.Duration {
+	days, err := strconv.Atoi(os.Getenv("TASK_TRASH_RETENTION_DAYS"))
+	if err != nil || days <= 0 {
+		days = 30
+	}
+
+	return time.Hour * 24 * time.Duration(days)
+}
+
 var (
-	ErrTaskNotFound = errors.New("task not found")
+	ErrTaskNotFound  = errors.New("task not found")
+	ErrInvalidCursor = errors.New("invalid cursor")
+
+	trashRetention = getTrashRetention()
 )
 
 func CreateTask(task model.Task) (*model.Task, error) {
-	result := db.Instance().
+	return createTask(db.Instance().DB, task)
+}
+
+func createTask(tx *gorm.DB, task model.Task) (*model.Task, error) {
+	result := tx.
 		Select("UserID", "Name", "Description", "Priority", "EstimatedTime", "Status", "StartTime", "EndTime").
 		Create(&task)
 	if result.Error != nil {
@@ -123,13 +144,20 @@ func CreateTask(task model.Task) (*model.Task, error) {
 	return &task, nil
 }
 
+// Updates the non-zero fields of the task.
+// The task is only updated if it belongs to "task.UserID".
 func UpdateTask(task model.Task) error {
-	result := db.Instance().
-		Model(&model.Task{}).
-		Where("id = ?", task.ID).
-		Updates(&task).
-		Limit(1)
+	return updateTask(db.Instance().DB, task)
+}
+
+func updateTask(tx *gorm.DB, task model.Task) error {
+	userID := task.UserID
+	task.UserID = nil // ownership is never changed
 
+	result := tx.
+		Model(&model.Task{}).
+		Where("id = ? AND user_id = ?", task.ID, userID).
+		Updates(&task)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -154,30 +182,99 @@ func GetAllTasks(userID int32) ([]model.Task, error) {
 }
 
 type GetCriteria struct {
-	UserID     int32
-	Status     *Status
-	Search     *string
-	Priority   *Priority
-	StartTime  *time.Time
-	EndTime    *time.Time
-	SortType   SortType
-	Pagination utils.Pagination
+	UserID        int32
+	Status        *Status
+	ExcludeStatus *Status
+	Search        *string
+	Priority      *Priority
+	StartTime     *time.Time
+	EndTime       *time.Time
+	SortType      SortType
+	Pagination    utils.Pagination
 }
 
-func GetTasks(criteria *GetCriteria) ([]model.Task, error) {
-	var tasks []model.Task
+// A task in a list, "Snippet" is only set when searching
+type ListedTask struct {
+	model.Task
+	Snippet *string
+}
+
+// A listed task with the value of its sort expression, used to create cursors
+type sortedTask struct {
+	ListedTask
+	SortKey *float64
+}
+
+// Position of the task in its keyset, the sort expression is NULL for unparsable dates
+func (t sortedTask) position() (any, int32) {
+	if t.SortKey == nil {
+		return nil, t.ID
+	}
+	return *t.SortKey, t.ID
+}
+
+// Priorities are stored as text, they are sorted by their rank from low to high
+const priorityRank = "CASE task.priority WHEN 'Low' THEN 0 WHEN 'Medium' THEN 1 WHEN 'High' THEN 2 END"
+
+// Keyset to order the tasks by, relevance is only used when searching.
+// Dates are compared as julian days, so that dates stored with different time zones are ordered correctly.
+func (st SortType) keyset(ftsQuery string) cursor.Keyset {
+	field := st.Field
+	if field == SortFieldRelevance && ftsQuery == "" {
+		field = SortFieldCreatedAt
+	}
+
+	keyset := cursor.Keyset{
+		Name:     fmt.Sprintf("%s:%s", field, st.Order),
+		IDColumn: "task.id",
+		Desc:     st.Order == SortOrderDesc,
+	}
+
+	switch field {
+	case SortFieldRelevance:
+		// bm25 is lower for better matches,
+		// the cursor is bound to the query since the score depends on it
+		keyset.Name = fmt.Sprintf("%s:%s", keyset.Name, ftsQuery)
+		keyset.Expression = "bm25(task_fts)"
+		keyset.Desc = !keyset.Desc
+	case SortFieldPriority:
+		keyset.Expression = priorityRank
+	default:
+		keyset.Expression = fmt.Sprintf("julianday(task.%s)", field)
+	}
+
+	return keyset
+}
+
+func GetTasks(criteria *GetCriteria) ([]ListedTask, error) {
+	var ftsQuery string
+	if criteria.Search != nil {
+		ftsQuery = toFtsQuery(*criteria.Search)
+
+		// A term with nothing to search for, such as punctuation only, matches no task rather than all of them
+		if ftsQuery == "" {
+			criteria.Pagination.Total = 0
+			criteria.Pagination.TotalPages = 0
+			criteria.Pagination.NextCursor = nil
+			return []ListedTask{}, nil
+		}
+	}
 
 	constructQuery := func(db *gorm.DB) *gorm.DB {
 		query := db.
 			Model(&model.Task{}).
-			Order(fmt.Sprintf("%s %s", criteria.SortType.Field, criteria.SortType.Order)).
 			Where("user_id = ?", criteria.UserID)
 
 		if criteria.Status != nil {
 			query = query.Where("status = ?", criteria.Status)
 		}
-		if criteria.Search != nil {
-			query = query.Where("name LIKE ?", fmt.Sprintf("%%%s%%", *criteria.Search))
+		if criteria.ExcludeStatus != nil {
+			query = query.Where("status <> ?", criteria.ExcludeStatus)
+		}
+		if ftsQuery != "" {
+			query = query.
+				Joins("JOIN task_fts ON task_fts.rowid = task.id").
+				Where("task_fts MATCH ?", ftsQuery)
 		}
 		if criteria.Priority != nil {
 			query = query.Where("priority = ?", criteria.Priority)
@@ -191,42 +288,47 @@ func GetTasks(criteria *GetCriteria) ([]model.Task, error) {
 		return query
 	}
 
-	var paginationQueryErr, listQueryError error
-	var wg sync.WaitGroup
-	wg.Add(2)
-
-	go func() {
-		defer wg.Done()
-		result := db.Instance().
-			Scopes(constructQuery).
-			Scopes(utils.Paginate(criteria.Pagination)).
-			Find(&tasks)
+	keyset := criteria.SortType.keyset(ftsQuery)
 
-		listQueryError = result.Error
-	}()
-
-	go func() {
-		defer wg.Done()
-		paginationQueryErr = utils.GetPaginationInfo(
-			&criteria.Pagination,
-			db.Instance().Scopes(constructQuery),
-		)
-	}()
-
-	wg.Wait()
+	columns := "task.*, " + keyset.Expression + " AS sort_key"
+	if ftsQuery != "" {
+		columns += ", " + snippetSelect + " AS snippet"
+	}
 
-	if paginationQueryErr != nil {
-		return nil, paginationQueryErr
+	var sorted []sortedTask
+	err := cursor.Find(
+		constructQuery,
+		columns,
+		keyset,
+		&criteria.Pagination,
+		&sorted,
+		sortedTask.position,
+	)
+	if err != nil {
+		if errors.Is(err, cursor.ErrInvalidCursor) {
+			return nil, ErrInvalidCursor
+		}
+		return nil, err
 	}
-	if listQueryError != nil {
-		return nil, listQueryError
+
+	tasks := make([]ListedTask, len(sorted))
+	for i, t := range sorted {
+		tasks[i] = t.ListedTask
+		if t.Snippet != nil {
+			tasks[i].Snippet = utils.Ptr(formatSnippet(*t.Snippet))
+		}
 	}
 
 	return tasks, nil
 }
 
+// Moves the task to the trash, it can be restored until it is purged.
 func DeleteTaskOfUser(taskId int32, userId int32) error {
-	result := db.Instance().
+	return deleteTaskOfUser(db.Instance().DB, taskId, userId)
+}
+
+func deleteTaskOfUser(tx *gorm.DB, taskId int32, userId int32) error {
+	result := tx.
 		Where("id = ? and user_id = ?", taskId, userId).
 		Delete(&model.Task{})
 
@@ -239,3 +341,97 @@ func DeleteTaskOfUser(taskId int32, userId int32) error {
 
 	return nil
 }
+
+func GetTrashedTasks(userID int32, pagination *utils.Pagination) ([]model.Task, error) {
+	constructQuery := func(db *gorm.DB) *gorm.DB {
+		return db.
+			Unscoped().
+			Model(&model.Task{}).
+			Where("user_id = ? AND deleted_at IS NOT NULL", userID)
+	}
+
+	keyset := cursor.Keyset{
+		Name:       "deleted_at:desc",
+		Expression: "julianday(task.deleted_at)",
+		IDColumn:   "task.id",
+		Desc:       true,
+	}
+
+	var sorted []sortedTask
+	err := cursor.Find(
+		constructQuery,
+		"task.*, "+keyset.Expression+" AS sort_key",
+		keyset,
+		pagination,
+		&sorted,
+		sortedTask.position,
+	)
+	if err != nil {
+		if errors.Is(err, cursor.ErrInvalidCursor) {
+			return nil, ErrInvalidCursor
+		}
+		return nil, err
+	}
+
+	tasks := make([]model.Task, len(sorted))
+	for i, t := range sorted {
+		tasks[i] = t.Task
+	}
+
+	return tasks, nil
+}
+
+func RestoreTaskOfUser(taskId int32, userId int32) error {
+	result := db.Instance().
+		Unscoped().
+		Model(&model.Task{}).
+		Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", taskId, userId).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTaskNotFound
+	}
+
+	return nil
+}
+
+// Permanently deletes the tasks which have been in the trash for longer than the retention period,
+// together with their focus sessions.
+// Returns the number of purged tasks.
+func PurgeTrash() (int64, error) {
+	deletedBefore := time.Now().Add(-trashRetention)
+
+	var purged int64
+	err := db.Instance().Transaction(func(tx *gorm.DB) error {
+		expiredTasks := tx.
+			Unscoped().
+			Model(&model.Task{}).
+			Select("id").
+			Where("deleted_at < ?", deletedBefore)
+
+		result := tx.
+			Where("task_id IN (?)", expiredTasks).
+			Delete(&model.FocusSession{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		result = tx.
+			Unscoped().
+			Where("deleted_at < ?", deletedBefore).
+			Delete(&model.Task{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		purged = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,92 @@
+package task
+
+import (
+	"path/filepath"
+	"slices"
+	"study-planner-api/internal/utils"
+	"study-planner-api/internal/utils/cursor"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestPrioritySort(t *testing.T) {
+	db, err := gorm.Open(sqlite.New(sqlite.Config{
+		DriverName: "libsql",
+		DSN:        "file:" + filepath.Join(t.TempDir(), "task.db"),
+	}), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.Exec("CREATE TABLE task (id INTEGER PRIMARY KEY, priority TEXT)").Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.Exec("INSERT INTO task (id, priority) VALUES (1, 'High'), (2, 'Low'), (3, 'Medium'), (4, 'Low')").Error
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type row struct {
+		ID      int32
+		SortKey *float64
+	}
+
+	find := func(keyset cursor.Keyset, after *cursor.Cursor) []int32 {
+		query := db.
+			Table("task").
+			Select("task.id, " + keyset.Expression + " AS sort_key").
+			Scopes(keyset.Order)
+		if after != nil {
+			query = query.Scopes(keyset.After(*after))
+		}
+
+		var rows []row
+		if err := query.Find(&rows).Error; err != nil {
+			t.Fatal(err)
+		}
+
+		ids := make([]int32, len(rows))
+		for i, r := range rows {
+			if r.SortKey == nil {
+				t.Fatalf("task %d has no sort key", r.ID)
+			}
+			ids[i] = r.ID
+		}
+		return ids
+	}
+
+	tests := []struct {
+		order SortOrder
+		after *cursor.Cursor
+		want  []int32
+	}{
+		{order: SortOrderAsc, want: []int32{2, 4, 3, 1}},
+		{order: SortOrderAsc, after: &cursor.Cursor{Value: float64(0), ID: 4}, want: []int32{3, 1}},
+		{order: SortOrderDesc, want: []int32{1, 3, 4, 2}},
+		{order: SortOrderDesc, after: &cursor.Cursor{Value: float64(1), ID: 3}, want: []int32{4, 2}},
+	}
+
+	for _, tt := range tests {
+		keyset := SortType{Field: SortFieldPriority, Order: tt.order}.keyset("")
+		if got := find(keyset, tt.after); !slices.Equal(got, tt.want) {
+			t.Errorf("priority %s after %v = %v, want %v", tt.order, tt.after, got, tt.want)
+		}
+	}
+}
+
+func TestSortedTaskPosition(t *testing.T) {
+	task := sortedTask{}
+	task.ID = 3
+
+	if value, id := task.position(); value != nil || id != 3 {
+		t.Errorf("position() without sort key = %v, %d, want nil, 3", value, id)
+	}
+
+	task.SortKey = utils.Ptr(2.5)
+	if value, id := task.position(); value != 2.5 || id != 3 {
+		t.Errorf("position() = %v, %d, want 2.5, 3", value, id)
+	}
+}
@@ -0,0 +1,99 @@
+package taskview
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidDate = errors.New("invalid date expression")
+
+	offsetPattern = regexp.MustCompile(`^([+-])(\d+)([hdwm])`)
+)
+
+// Resolves a date expression relative to "now", in the location of "now", with weeks starting on "weekStart".
+// An expression starts with a base, followed by any number of offsets:
+//   - bases: "now", "today" (start of the day), "week" (start of the week),
+//     "month" (start of the month) or an absolute date such as "2025-01-31"
+//   - offsets: a sign, a number and a unit, "h" for hours, "d" for days, "w" for weeks
+//     or "m" for months, e.g. "today+7d" or "week+1w-1d"
+func ResolveDate(expr string, now time.Time, weekStart time.Weekday) (time.Time, error) {
+	expr = strings.ToLower(strings.TrimSpace(expr))
+
+	baseEnd := strings.IndexAny(expr, "+-")
+	// The dashes of an absolute date are not offsets
+	if len(expr) >= len(time.DateOnly) && isAbsoluteDate(expr[:len(time.DateOnly)]) {
+		baseEnd = len(time.DateOnly)
+	}
+	if baseEnd == -1 {
+		baseEnd = len(expr)
+	}
+
+	date, err := resolveBase(expr[:baseEnd], now, weekStart)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	offsets := expr[baseEnd:]
+	for offsets != "" {
+		match := offsetPattern.FindStringSubmatch(offsets)
+		if match == nil {
+			return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidDate, expr)
+		}
+
+		amount, err := strconv.Atoi(match[2])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidDate, expr)
+		}
+		if match[1] == "-" {
+			amount = -amount
+		}
+
+		switch match[3] {
+		case "h":
+			date = date.Add(time.Duration(amount) * time.Hour)
+		case "d":
+			date = date.AddDate(0, 0, amount)
+		case "w":
+			date = date.AddDate(0, 0, amount*7)
+		case "m":
+			date = date.AddDate(0, amount, 0)
+		}
+
+		offsets = offsets[len(match[0]):]
+	}
+
+	return date, nil
+}
+
+func resolveBase(base string, now time.Time, weekStart time.Weekday) (time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch base {
+	case "now":
+		return now, nil
+	case "today":
+		return today, nil
+	case "week":
+		daysSinceStart := (int(today.Weekday()) - int(weekStart) + 7) % 7
+		return today.AddDate(0, 0, -daysSinceStart), nil
+	case "month":
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()), nil
+	}
+
+	date, err := time.ParseInLocation(time.DateOnly, base, now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidDate, base)
+	}
+
+	return date, nil
+}
+
+func isAbsoluteDate(str string) bool {
+	_, err := time.Parse(time.DateOnly, str)
+	return err == nil
+}
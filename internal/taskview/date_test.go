@@ -0,0 +1,93 @@
+package taskview
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestResolveDate(t *testing.T) {
+	// A Wednesday
+	now := time.Date(2025, time.January, 15, 13, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{expr: "now", want: now},
+		{expr: "today", want: time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC)},
+		{expr: "today+7d", want: time.Date(2025, time.January, 22, 0, 0, 0, 0, time.UTC)},
+		{expr: " Today-1d ", want: time.Date(2025, time.January, 14, 0, 0, 0, 0, time.UTC)},
+		{expr: "now+2h", want: time.Date(2025, time.January, 15, 15, 30, 0, 0, time.UTC)},
+		{expr: "week", want: time.Date(2025, time.January, 13, 0, 0, 0, 0, time.UTC)},
+		{expr: "week+1w-1d", want: time.Date(2025, time.January, 19, 0, 0, 0, 0, time.UTC)},
+		{expr: "month+1m", want: time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{expr: "2025-03-01", want: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{expr: "2025-03-01-1d", want: time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		got, err := ResolveDate(tt.expr, now, time.Monday)
+		if err != nil {
+			t.Errorf("ResolveDate(%q) returned error: %v", tt.expr, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ResolveDate(%q) = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestResolveDateInvalid(t *testing.T) {
+	now := time.Date(2025, time.January, 15, 13, 30, 0, 0, time.UTC)
+
+	for _, expr := range []string{"", "tomorrow", "today+", "today+7", "today+7y", "today 7d", "2025-13-01"} {
+		if _, err := ResolveDate(expr, now, time.Monday); !errors.Is(err, ErrInvalidDate) {
+			t.Errorf("ResolveDate(%q) error = %v, want ErrInvalidDate", expr, err)
+		}
+	}
+}
+
+func TestResolveDateSunday(t *testing.T) {
+	sunday := time.Date(2025, time.January, 19, 8, 0, 0, 0, time.UTC)
+	want := time.Date(2025, time.January, 13, 0, 0, 0, 0, time.UTC)
+
+	if got, _ := ResolveDate("week", sunday, time.Monday); !got.Equal(want) {
+		t.Errorf("ResolveDate(\"week\") on Sunday = %v, want %v", got, want)
+	}
+}
+
+func TestResolveDateWeekStart(t *testing.T) {
+	// A Wednesday
+	now := time.Date(2025, time.January, 15, 13, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		weekStart time.Weekday
+		want      time.Time
+	}{
+		{weekStart: time.Sunday, want: time.Date(2025, time.January, 12, 0, 0, 0, 0, time.UTC)},
+		{weekStart: time.Wednesday, want: time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC)},
+		{weekStart: time.Saturday, want: time.Date(2025, time.January, 11, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		if got, _ := ResolveDate("week", now, tt.weekStart); !got.Equal(tt.want) {
+			t.Errorf("ResolveDate(\"week\") starting on %s = %v, want %v", tt.weekStart, got, tt.want)
+		}
+	}
+}
+
+func TestResolveDateLocation(t *testing.T) {
+	location, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	if err != nil {
+		t.Skip("no time zone database")
+	}
+
+	// Already the next day in UTC+7
+	now := time.Date(2025, time.January, 15, 20, 0, 0, 0, time.UTC).In(location)
+	want := time.Date(2025, time.January, 16, 0, 0, 0, 0, location)
+
+	if got, _ := ResolveDate("today", now, time.Monday); !got.Equal(want) {
+		t.Errorf("ResolveDate(\"today\") = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,243 @@
+package taskview
+
+import (
+	"errors"
+	"fmt"
+	db "study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"study-planner-api/internal/task"
+	"study-planner-api/internal/user/profile"
+	"study-planner-api/internal/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrViewNotFound  = errors.New("task view not found")
+	ErrViewNameTaken = errors.New("task view name already taken")
+	ErrInvalidView   = errors.New("invalid task view")
+)
+
+// Converts the filters of the view to task criteria, resolving the date expressions relative to "now",
+// in its location, with weeks starting on "weekStart".
+func Criteria(view model.TaskView, now time.Time, weekStart time.Weekday) (task.GetCriteria, error) {
+	criteria := task.GetCriteria{
+		UserID: view.UserID,
+		Search: view.Search,
+		SortType: task.SortType{
+			Field: task.SortFieldCreatedAt,
+			Order: task.SortOrderDesc,
+		},
+	}
+
+	if view.Status != nil {
+		status, err := task.StatusFromString(*view.Status)
+		if err != nil {
+			return task.GetCriteria{}, fmt.Errorf("%w: %w", ErrInvalidView, err)
+		}
+		criteria.Status = &status
+	}
+
+	if view.ExcludeStatus != nil {
+		status, err := task.StatusFromString(*view.ExcludeStatus)
+		if err != nil {
+			return task.GetCriteria{}, fmt.Errorf("%w: %w", ErrInvalidView, err)
+		}
+		criteria.ExcludeStatus = &status
+	}
+
+	if view.Priority != nil {
+		priority, err := task.PriorityFromString(*view.Priority)
+		if err != nil {
+			return task.GetCriteria{}, fmt.Errorf("%w: %w", ErrInvalidView, err)
+		}
+		criteria.Priority = &priority
+	}
+
+	if view.StartDate != nil {
+		startTime, err := ResolveDate(*view.StartDate, now, weekStart)
+		if err != nil {
+			return task.GetCriteria{}, fmt.Errorf("%w: %w", ErrInvalidView, err)
+		}
+		criteria.StartTime = &startTime
+	}
+
+	if view.EndDate != nil {
+		endTime, err := ResolveDate(*view.EndDate, now, weekStart)
+		if err != nil {
+			return task.GetCriteria{}, fmt.Errorf("%w: %w", ErrInvalidView, err)
+		}
+		criteria.EndTime = &endTime
+	}
+
+	if view.SortBy != nil {
+		sortBy, err := task.SortFieldFromString(*view.SortBy)
+		if err != nil {
+			return task.GetCriteria{}, fmt.Errorf("%w: %w", ErrInvalidView, err)
+		}
+		criteria.SortType.Field = sortBy
+	} else if view.Search != nil {
+		criteria.SortType.Field = task.SortFieldRelevance
+	}
+
+	if view.SortOrder != nil {
+		sortOrder, err := task.SortOrderFromString(*view.SortOrder)
+		if err != nil {
+			return task.GetCriteria{}, fmt.Errorf("%w: %w", ErrInvalidView, err)
+		}
+		criteria.SortType.Order = sortOrder
+	}
+
+	return criteria, nil
+}
+
+func CreateView(view model.TaskView) (*model.TaskView, error) {
+	if _, err := Criteria(view, time.Now(), time.Monday); err != nil {
+		return nil, err
+	}
+
+	err := db.Instance().Transaction(func(tx *gorm.DB) error {
+		if err := ensureNameAvailable(tx, view); err != nil {
+			return err
+		}
+
+		return tx.
+			Select("UserID", "Name", "Status", "ExcludeStatus", "Priority", "Search", "StartDate", "EndDate", "SortBy", "SortOrder").
+			Create(&view).
+			Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &view, nil
+}
+
+// Replaces the name and filters of the view.
+// The view is only updated if it belongs to "view.UserID".
+func UpdateView(view model.TaskView) error {
+	if _, err := Criteria(view, time.Now(), time.Monday); err != nil {
+		return err
+	}
+
+	return db.Instance().Transaction(func(tx *gorm.DB) error {
+		if err := ensureNameAvailable(tx, view); err != nil {
+			return err
+		}
+
+		result := tx.
+			Model(&model.TaskView{}).
+			Where("id = ? AND user_id = ?", view.ID, view.UserID).
+			Select("Name", "Status", "ExcludeStatus", "Priority", "Search", "StartDate", "EndDate", "SortBy", "SortOrder", "UpdatedAt").
+			Updates(&model.TaskView{
+				Name:          view.Name,
+				Status:        view.Status,
+				ExcludeStatus: view.ExcludeStatus,
+				Priority:      view.Priority,
+				Search:        view.Search,
+				StartDate:     view.StartDate,
+				EndDate:       view.EndDate,
+				SortBy:        view.SortBy,
+				SortOrder:     view.SortOrder,
+				UpdatedAt:     utils.Ptr(time.Now()),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrViewNotFound
+		}
+
+		return nil
+	})
+}
+
+// Checks that no other view of the user has the same name
+func ensureNameAvailable(tx *gorm.DB, view model.TaskView) error {
+	var count int64
+	result := tx.
+		Model(&model.TaskView{}).
+		Where("user_id = ? AND name = ? AND id <> ?", view.UserID, view.Name, view.ID).
+		Count(&count)
+	if result.Error != nil {
+		return result.Error
+	}
+	if count > 0 {
+		return ErrViewNameTaken
+	}
+
+	return nil
+}
+
+func GetViews(userID int32) ([]model.TaskView, error) {
+	var views []model.TaskView
+	result := db.Instance().
+		Model(&model.TaskView{}).
+		Where("user_id = ?", userID).
+		Order("name").
+		Find(&views)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return views, nil
+}
+
+func GetViewOfUser(viewID int32, userID int32) (*model.TaskView, error) {
+	var view model.TaskView
+	result := db.Instance().
+		Model(&model.TaskView{}).
+		Where("id = ? AND user_id = ?", viewID, userID).
+		First(&view)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrViewNotFound
+		}
+		return nil, result.Error
+	}
+
+	return &view, nil
+}
+
+func DeleteViewOfUser(viewID int32, userID int32) error {
+	result := db.Instance().
+		Where("id = ? AND user_id = ?", viewID, userID).
+		Delete(&model.TaskView{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrViewNotFound
+	}
+
+	return nil
+}
+
+// Executes the saved view, with its date expressions resolved at the time of the call,
+// in the time zone of the user and with their first day of the week.
+func GetViewTasks(viewID int32, userID int32, pagination *utils.Pagination) ([]task.ListedTask, error) {
+	view, err := GetViewOfUser(viewID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := profile.Get(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria, err := Criteria(*view, time.Now().In(preferences.Location()), preferences.WeekStartDay)
+	if err != nil {
+		return nil, err
+	}
+	criteria.Pagination = *pagination
+
+	tasks, err := task.GetTasks(&criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	*pagination = criteria.Pagination
+	return tasks, nil
+}
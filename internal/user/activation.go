@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"study-planner-api/internal/audit"
 	"study-planner-api/internal/auth/token"
 	"study-planner-api/internal/database"
 	"study-planner-api/internal/model"
@@ -97,7 +98,7 @@ func SendActivationEmail(userId int32) error {
 	return nil
 }
 
-func ActivateAccount(userId int32, activationCode string) error {
+func ActivateAccount(userId int32, activationCode string, client audit.Client) error {
 	var t model.Token
 	result := database.Instance().
 		Select("token.*").
@@ -142,5 +143,9 @@ func ActivateAccount(userId int32, activationCode string) error {
 		return errors.New("cannot update, something went wrong")
 	}
 
-	return nil
+	return audit.Record(audit.Event{
+		UserID: &userId,
+		Type:   audit.EventAccountActivated,
+		Client: &client,
+	})
 }
@@ -0,0 +1,330 @@
+// Profile and preferences of users.
+package profile
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"study-planner-api/internal/database"
+	"study-planner-api/internal/model"
+	"time"
+	"unicode/utf8"
+
+	// Time zones are validated the same whether or not the system has a time zone database
+	_ "time/tzdata"
+
+	"golang.org/x/text/language"
+	"gorm.io/gorm"
+)
+
+const (
+	MaxDisplayNameLength = 100
+	MaxAvatarUrlLength   = 2048
+
+	// Bounds of the default durations, in seconds
+	MinDuration = 60
+	MaxDuration = 4 * 60 * 60
+
+	// Duration of a focus session started without one, when the user has no default
+	DefaultFocusDuration int32 = 25 * 60
+)
+
+var (
+	ErrUserNotFound        = errors.New("user not found")
+	ErrInvalidDisplayName  = errors.New("invalid display name")
+	ErrInvalidAvatarUrl    = errors.New("invalid avatar url")
+	ErrInvalidLocale       = errors.New("invalid locale")
+	ErrInvalidTimezone     = errors.New("invalid timezone")
+	ErrInvalidWeekStartDay = errors.New("invalid week start day")
+	ErrInvalidDuration     = errors.New("invalid duration")
+)
+
+type Profile struct {
+	DisplayName *string
+	AvatarUrl   *string
+	Locale      *string
+	Timezone    *string
+	// Monday unless set otherwise
+	WeekStartDay time.Weekday
+	// In seconds
+	DefaultFocusDuration *int32
+	DefaultBreakDuration *int32
+}
+
+// Changes of a profile, the fields which are nil are left unchanged.
+// Empty strings and zero durations clear the field.
+type Update struct {
+	DisplayName          *string
+	AvatarUrl            *string
+	Locale               *string
+	Timezone             *string
+	WeekStartDay         *int32
+	DefaultFocusDuration *int32
+	DefaultBreakDuration *int32
+}
+
+func toProfile(user model.User) Profile {
+	return Profile{
+		DisplayName:          user.DisplayName,
+		AvatarUrl:            user.AvatarURL,
+		Locale:               user.Locale,
+		Timezone:             user.Timezone,
+		WeekStartDay:         time.Weekday(user.WeekStartDay),
+		DefaultFocusDuration: user.DefaultFocusDuration,
+		DefaultBreakDuration: user.DefaultBreakDuration,
+	}
+}
+
+// Location of the time zone of the user, UTC when it is not set
+func (p Profile) Location() *time.Location {
+	if p.Timezone == nil {
+		return time.UTC
+	}
+
+	location, err := time.LoadLocation(*p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+func Get(userID int32) (Profile, error) {
+	var user model.User
+	result := database.Instance().
+		Where("id = ?", userID).
+		First(&user)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return Profile{}, ErrUserNotFound
+		}
+		return Profile{}, result.Error
+	}
+
+	return toProfile(user), nil
+}
+
+// Applies the changes to the profile of the user, all of them or none if one is invalid
+func Apply(userID int32, update Update) (Profile, error) {
+	columns, err := update.columns()
+	if err != nil {
+		return Profile{}, err
+	}
+
+	var profile Profile
+	err = database.Instance().Transaction(func(tx *gorm.DB) error {
+		err := ApplyTx(tx, userID, columns)
+		if err != nil {
+			return err
+		}
+
+		var user model.User
+		result := tx.Where("id = ?", userID).First(&user)
+		if result.Error != nil {
+			return result.Error
+		}
+		profile = toProfile(user)
+
+		return nil
+	})
+	if err != nil {
+		return Profile{}, err
+	}
+
+	return profile, nil
+}
+
+// Updates the columns of the profile of the user, as given by Update.columns or FromClaims
+func ApplyTx(tx *gorm.DB, userID int32, columns map[string]any) error {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	result := tx.
+		Model(&model.User{}).
+		Where("id = ?", userID).
+		Updates(columns)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// Gets the columns to update, validating and normalizing the changes
+func (u Update) columns() (map[string]any, error) {
+	columns := make(map[string]any)
+
+	if u.DisplayName != nil {
+		name, err := normalizeDisplayName(*u.DisplayName)
+		if err != nil {
+			return nil, err
+		}
+		columns["display_name"] = nullIfEmpty(name)
+	}
+
+	if u.AvatarUrl != nil {
+		avatarUrl, err := normalizeAvatarUrl(*u.AvatarUrl)
+		if err != nil {
+			return nil, err
+		}
+		columns["avatar_url"] = nullIfEmpty(avatarUrl)
+	}
+
+	if u.Locale != nil {
+		locale, err := normalizeLocale(*u.Locale)
+		if err != nil {
+			return nil, err
+		}
+		columns["locale"] = nullIfEmpty(locale)
+	}
+
+	if u.Timezone != nil {
+		timezone, err := normalizeTimezone(*u.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		columns["timezone"] = nullIfEmpty(timezone)
+	}
+
+	if u.WeekStartDay != nil {
+		if *u.WeekStartDay < int32(time.Sunday) || *u.WeekStartDay > int32(time.Saturday) {
+			return nil, ErrInvalidWeekStartDay
+		}
+		columns["week_start_day"] = *u.WeekStartDay
+	}
+
+	for column, duration := range map[string]*int32{
+		"default_focus_duration": u.DefaultFocusDuration,
+		"default_break_duration": u.DefaultBreakDuration,
+	} {
+		if duration == nil {
+			continue
+		}
+		if *duration == 0 {
+			columns[column] = nil
+			continue
+		}
+		if *duration < MinDuration || *duration > MaxDuration {
+			return nil, ErrInvalidDuration
+		}
+		columns[column] = *duration
+	}
+
+	return columns, nil
+}
+
+// Claims about the user from an identity provider
+type Claims struct {
+	Name       string
+	PictureUrl string
+	Locale     string
+	// IANA time zone, named "zoneinfo" in OpenID Connect
+	Zoneinfo string
+}
+
+// Gets the columns of the profile of a new user, from the claims of the provider.
+// Claims are not under the control of the user, so the invalid ones are skipped rather than refused.
+func FromClaims(claims Claims) map[string]any {
+	columns := make(map[string]any)
+
+	// Names too long are cut rather than dropped
+	name := truncate(strings.TrimSpace(claims.Name), MaxDisplayNameLength)
+	if name, err := normalizeDisplayName(name); err == nil && name != "" {
+		columns["display_name"] = name
+	}
+	if avatarUrl, err := normalizeAvatarUrl(claims.PictureUrl); err == nil && avatarUrl != "" {
+		columns["avatar_url"] = avatarUrl
+	}
+	if locale, err := normalizeLocale(claims.Locale); err == nil && locale != "" {
+		columns["locale"] = locale
+	}
+	if timezone, err := normalizeTimezone(claims.Zoneinfo); err == nil && timezone != "" {
+		columns["timezone"] = timezone
+	}
+
+	return columns
+}
+
+func nullIfEmpty(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+func truncate(value string, maxLength int) string {
+	if utf8.RuneCountInString(value) <= maxLength {
+		return value
+	}
+	return string([]rune(value)[:maxLength])
+}
+
+func normalizeDisplayName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > MaxDisplayNameLength || !utf8.ValidString(name) {
+		return "", ErrInvalidDisplayName
+	}
+	for _, c := range name {
+		if c < 0x20 || c == 0x7f {
+			return "", ErrInvalidDisplayName
+		}
+	}
+
+	return name, nil
+}
+
+// Only absolute http(s) URLs, which clients can load without surprises
+func normalizeAvatarUrl(rawUrl string) (string, error) {
+	rawUrl = strings.TrimSpace(rawUrl)
+	if rawUrl == "" {
+		return "", nil
+	}
+	if len(rawUrl) > MaxAvatarUrlLength {
+		return "", ErrInvalidAvatarUrl
+	}
+
+	parsed, err := url.Parse(rawUrl)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" || parsed.User != nil {
+		return "", ErrInvalidAvatarUrl
+	}
+
+	return parsed.String(), nil
+}
+
+// Canonicalizes the locale as a BCP 47 tag, the underscores of POSIX locales being accepted
+func normalizeLocale(locale string) (string, error) {
+	locale = strings.TrimSpace(locale)
+	if locale == "" {
+		return "", nil
+	}
+
+	tag, err := language.Parse(strings.ReplaceAll(locale, "_", "-"))
+	if err != nil || tag == language.Und {
+		return "", ErrInvalidLocale
+	}
+
+	return tag.String(), nil
+}
+
+func normalizeTimezone(timezone string) (string, error) {
+	timezone = strings.TrimSpace(timezone)
+	if timezone == "" {
+		return "", nil
+	}
+
+	// "Local" depends on the server rather than the user
+	if timezone == "Local" {
+		return "", ErrInvalidTimezone
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return "", ErrInvalidTimezone
+	}
+
+	return location.String(), nil
+}
@@ -0,0 +1,104 @@
+package profile
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"study-planner-api/internal/utils"
+	"testing"
+)
+
+func TestUpdateColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  Update
+		want    map[string]any
+		wantErr error
+	}{
+		{
+			name: "normalizes values",
+			update: Update{
+				DisplayName:  utils.Ptr("  Ada Lovelace "),
+				Locale:       utils.Ptr("en_gb"),
+				Timezone:     utils.Ptr("Europe/Paris"),
+				WeekStartDay: utils.Ptr(int32(0)),
+			},
+			want: map[string]any{
+				"display_name":   "Ada Lovelace",
+				"locale":         "en-GB",
+				"timezone":       "Europe/Paris",
+				"week_start_day": int32(0),
+			},
+		},
+		{
+			name: "clears with empty values",
+			update: Update{
+				AvatarUrl:            utils.Ptr(""),
+				DefaultFocusDuration: utils.Ptr(int32(0)),
+			},
+			want: map[string]any{
+				"avatar_url":             nil,
+				"default_focus_duration": nil,
+			},
+		},
+		{
+			name:    "refuses unknown time zones",
+			update:  Update{Timezone: utils.Ptr("Mars/Olympus_Mons")},
+			wantErr: ErrInvalidTimezone,
+		},
+		{
+			name:    "refuses the local time zone of the server",
+			update:  Update{Timezone: utils.Ptr("Local")},
+			wantErr: ErrInvalidTimezone,
+		},
+		{
+			name:    "refuses invalid locales",
+			update:  Update{Locale: utils.Ptr("not a locale")},
+			wantErr: ErrInvalidLocale,
+		},
+		{
+			name:    "refuses avatars which are not web URLs",
+			update:  Update{AvatarUrl: utils.Ptr("javascript:alert(1)")},
+			wantErr: ErrInvalidAvatarUrl,
+		},
+		{
+			name:    "refuses days out of the week",
+			update:  Update{WeekStartDay: utils.Ptr(int32(7))},
+			wantErr: ErrInvalidWeekStartDay,
+		},
+		{
+			name:    "refuses durations out of bounds",
+			update:  Update{DefaultBreakDuration: utils.Ptr(int32(30))},
+			wantErr: ErrInvalidDuration,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.update.columns()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("columns() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("columns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromClaims(t *testing.T) {
+	got := FromClaims(Claims{
+		Name:       strings.Repeat("a", MaxDisplayNameLength+10),
+		PictureUrl: "data:image/png;base64,AAAA",
+		Locale:     "fr-FR",
+		Zoneinfo:   "not/a_zone",
+	})
+
+	want := map[string]any{
+		"display_name": strings.Repeat("a", MaxDisplayNameLength),
+		"locale":       "fr-FR",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FromClaims() = %v, want %v", got, want)
+	}
+}
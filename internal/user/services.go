@@ -2,43 +2,74 @@ package user
 
 import (
 	"errors"
+	"study-planner-api/internal/audit"
 	db "study-planner-api/internal/database"
 	"study-planner-api/internal/model"
-	"study-planner-api/internal/utils"
+	"study-planner-api/internal/validator/passwordpolicy"
 	"time"
 
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 )
 
 type UserInfo struct {
-	ID        int32
-	Email     string
-	CreatedAt time.Time
+	ID          int32
+	Email       string
+	CreatedAt   time.Time
+	IsActivated bool
+	Role        string
 }
 
 var (
 	ErrUserExists = errors.New("user already exists")
 )
 
-func CreateUser(email string, password string) (id int32, err error) {
-	// Hash password with bcrypt
+// Checks the password against the password policy, then hashes it with bcrypt.
+// The policy error is a *passwordpolicy.Error, "userInputs" being the email of the user and the like.
+func HashPassword(password string, userInputs ...string) (string, error) {
+	err := passwordpolicy.Get().Check(password, userInputs...)
+	if err != nil {
+		return "", err
+	}
+
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hashedPassword), nil
+}
+
+// Registers a user with the email and password, from the client "client"
+func CreateUser(email string, password string, client audit.Client) (id int32, err error) {
+	hashedPassword, err := HashPassword(password, email)
 	if err != nil {
 		return -1, err
 	}
 
 	user := model.User{
 		Email:    &email,
-		Password: utils.Ptr(string(hashedPassword)),
+		Password: &hashedPassword,
 	}
 
-	// Create user in database
-	result := db.Instance().Select("Email", "Password").Create(&user)
-	if result.RowsAffected == 0 {
-		return -1, ErrUserExists
-	}
-	if result.Error != nil {
-		return -1, result.Error
+	err = db.Instance().Transaction(func(tx *gorm.DB) error {
+		// Create user in database
+		result := tx.Select("Email", "Password").Create(&user)
+		if result.RowsAffected == 0 {
+			return ErrUserExists
+		}
+		if result.Error != nil {
+			return result.Error
+		}
+
+		return audit.RecordTx(tx, audit.Event{
+			UserID: &user.ID,
+			Type:   audit.EventRegistered,
+			Client: &client,
+		})
+	})
+	if err != nil {
+		return -1, err
 	}
 
 	return user.ID, nil
@@ -57,29 +88,30 @@ func GetUserInfo(id int32) (UserInfo, error) {
 	return info, nil
 }
 
-func GetUserInfoByGoogleID(googleID string) (UserInfo, error) {
-	var info UserInfo
+func UpdatePassword(id int32, newPassword string) error {
+	var user model.User
 	result := db.Instance().
-		Model(&model.User{}).
-		Where("google_id = ?", googleID).
-		First(&info)
+		Select("email").
+		Where("id = ?", id).
+		First(&user)
 	if result.Error != nil {
-		return UserInfo{}, result.Error
+		return result.Error
 	}
 
-	return info, nil
-}
+	var userInputs []string
+	if user.Email != nil {
+		userInputs = append(userInputs, *user.Email)
+	}
 
-func UpdatePassword(id int32, newPassword string) error {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	hashedPassword, err := HashPassword(newPassword, userInputs...)
 	if err != nil {
 		return err
 	}
 
-	result := db.Instance().
+	result = db.Instance().
 		Model(&model.User{ID: id}).
 		Where("id = ?", id).
-		Update("password", string(hashedPassword))
+		Update("password", hashedPassword)
 	if result.Error != nil {
 		return result.Error
 	}
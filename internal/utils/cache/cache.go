@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// In-memory cache whose entries expire a fixed duration after being set.
+// When the cache is full, expired entries are evicted, then an arbitrary entry if still needed.
+// Safe for concurrent use.
+type TTL[K comparable, V any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	maxSize int
+	entries map[K]entry[V]
+
+	// Replaced in tests
+	now func() time.Time
+}
+
+func NewTTL[K comparable, V any](ttl time.Duration, maxSize int) *TTL[K, V] {
+	return &TTL[K, V]{
+		ttl:     ttl,
+		maxSize: maxSize,
+		entries: make(map[K]entry[V]),
+		now:     time.Now,
+	}
+}
+
+func (c *TTL[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || !c.now().Before(e.expiresAt) {
+		delete(c.entries, key)
+		return *new(V), false
+	}
+
+	return e.value, true
+}
+
+func (c *TTL[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxSize {
+		c.evict()
+	}
+
+	c.entries[key] = entry[V]{value: value, expiresAt: c.now().Add(c.ttl)}
+}
+
+// Sets the entry only if the key has no unexpired entry.
+// Returns whether the entry was set.
+func (c *TTL[K, V]) Add(key K, value V) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok && c.now().Before(e.expiresAt) {
+		return false
+	}
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxSize {
+		c.evict()
+	}
+
+	c.entries[key] = entry[V]{value: value, expiresAt: c.now().Add(c.ttl)}
+	return true
+}
+
+func (c *TTL[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// Deletes every entry whose key matches
+func (c *TTL[K, V]) DeleteFunc(match func(key K) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if match(key) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Makes room for one entry, must be called with the lock held
+func (c *TTL[K, V]) evict() {
+	now := c.now()
+	for key, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+
+	for key := range c.entries {
+		if len(c.entries) < c.maxSize {
+			break
+		}
+		delete(c.entries, key)
+	}
+}
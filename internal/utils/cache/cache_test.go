@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestCache(maxSize int) (*TTL[string, int], *time.Time) {
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	c := NewTTL[string, int](time.Minute, maxSize)
+	c.now = func() time.Time { return now }
+
+	return c, &now
+}
+
+func TestTTLExpiry(t *testing.T) {
+	c, now := newTestCache(10)
+
+	c.Set("a", 1)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("Get(a) = %d, %t, want 1, true", v, ok)
+	}
+
+	*now = now.Add(time.Minute)
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("Get(a) found an expired entry")
+	}
+}
+
+func TestTTLEviction(t *testing.T) {
+	c, now := newTestCache(2)
+
+	c.Set("a", 1)
+	*now = now.Add(time.Minute)
+	c.Set("b", 2)
+	c.Set("c", 3) // "a" has expired and is evicted first
+
+	if _, ok := c.Get("b"); !ok {
+		t.Errorf("Get(b) did not find an unexpired entry")
+	}
+	if _, ok := c.Get("c"); !ok {
+		t.Errorf("Get(c) did not find the new entry")
+	}
+
+	c.Set("d", 4)
+	if len(c.entries) != 2 {
+		t.Errorf("cache has %d entries, want at most 2", len(c.entries))
+	}
+	if _, ok := c.Get("d"); !ok {
+		t.Errorf("Get(d) did not find the new entry")
+	}
+}
+
+func TestTTLDeleteFunc(t *testing.T) {
+	c, _ := newTestCache(10)
+
+	c.Set("user:1:a", 1)
+	c.Set("user:1:b", 2)
+	c.Set("user:2:a", 3)
+	c.DeleteFunc(func(key string) bool { return key[:6] == "user:1" })
+
+	if _, ok := c.Get("user:1:a"); ok {
+		t.Errorf("Get(user:1:a) found a deleted entry")
+	}
+	if _, ok := c.Get("user:2:a"); !ok {
+		t.Errorf("Get(user:2:a) did not find a kept entry")
+	}
+}
+
+func TestTTLAdd(t *testing.T) {
+	c, now := newTestCache(10)
+
+	if !c.Add("a", 1) {
+		t.Fatalf("Add(a) did not set a new entry")
+	}
+	if c.Add("a", 2) {
+		t.Errorf("Add(a) replaced an unexpired entry")
+	}
+
+	*now = now.Add(time.Minute)
+	if !c.Add("a", 3) {
+		t.Errorf("Add(a) did not replace an expired entry")
+	}
+	if v, _ := c.Get("a"); v != 3 {
+		t.Errorf("Get(a) = %d, want 3", v)
+	}
+}
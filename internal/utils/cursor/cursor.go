@@ -0,0 +1,186 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	db "study-planner-api/internal/database"
+	"study-planner-api/internal/utils"
+	"study-planner-api/internal/utils/signature"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// Position of the last item of a page
+type Cursor struct {
+	Keyset string `json:"k"`
+	Value  any    `json:"v"`
+	ID     int32  `json:"id"`
+}
+
+// An ordering by a sort expression, with the row ID as tiebreaker so every row has a unique position.
+// NULL values of the expression are ordered first in ascending order, as done by SQLite.
+type Keyset struct {
+	// Identifies the ordering, cursors created for another ordering are rejected
+	Name       string
+	Expression string
+	IDColumn   string
+	Desc       bool
+}
+
+func (k Keyset) direction() string {
+	if k.Desc {
+		return "DESC"
+	}
+	return "ASC"
+}
+
+// Scope which orders the query by the keyset
+func (k Keyset) Order(db *gorm.DB) *gorm.DB {
+	return db.
+		Order(fmt.Sprintf("%s %s", k.Expression, k.direction())).
+		Order(fmt.Sprintf("%s %s", k.IDColumn, k.direction()))
+}
+
+// Scope which only keeps the rows positioned after the cursor
+func (k Keyset) After(c Cursor) func(db *gorm.DB) *gorm.DB {
+	expr, id := k.Expression, k.IDColumn
+
+	return func(db *gorm.DB) *gorm.DB {
+		switch {
+		case !k.Desc && c.Value == nil:
+			return db.Where(
+				fmt.Sprintf("((%s IS NULL AND %s > ?) OR %s IS NOT NULL)", expr, id, expr),
+				c.ID,
+			)
+		case !k.Desc:
+			return db.Where(
+				fmt.Sprintf("(%s > ? OR (%s = ? AND %s > ?))", expr, expr, id),
+				c.Value, c.Value, c.ID,
+			)
+		case c.Value == nil:
+			return db.Where(
+				fmt.Sprintf("%s IS NULL AND %s < ?", expr, id),
+				c.ID,
+			)
+		default:
+			return db.Where(
+				fmt.Sprintf("(%s < ? OR (%s = ? AND %s < ?) OR %s IS NULL)", expr, expr, id, expr),
+				c.Value, c.Value, c.ID,
+			)
+		}
+	}
+}
+
+// Creates an opaque, signed cursor pointing at the row with the given sort value and ID
+func (k Keyset) Encode(value any, id int32) (string, error) {
+	payload, err := json.Marshal(Cursor{Keyset: k.Name, Value: value, ID: id})
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + signature.Sign(encoded), nil
+}
+
+// Verifies and decodes a cursor created by "Encode" for this keyset
+func (k Keyset) Decode(value string) (Cursor, error) {
+	encoded, sig, found := strings.Cut(value, ".")
+	if !found || !signature.Verify(encoded, sig) {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var c Cursor
+	err = json.Unmarshal(payload, &c)
+	if err != nil || c.Keyset != k.Name {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return c, nil
+}
+
+// Finds a page of the rows matching "filter", ordered by the keyset.
+// The page starts after "pagination.Cursor" when set, otherwise at "pagination.Page".
+// "pagination.NextCursor" is set when there are more rows after the page,
+// and the total is only counted when "pagination.WithTotal" is set.
+// "columns" are selected for the page only, the default is used when empty.
+// "positionOf" returns the sort value and ID of a row.
+func Find[T any](
+	filter func(db *gorm.DB) *gorm.DB,
+	columns string,
+	keyset Keyset,
+	pagination *utils.Pagination,
+	dest *[]T,
+	positionOf func(T) (any, int32),
+) error {
+	limit := pagination.GetLimit()
+
+	pageQuery := db.Instance().
+		Scopes(filter).
+		Scopes(keyset.Order)
+	if columns != "" {
+		pageQuery = pageQuery.Select(columns)
+	}
+
+	if pagination.Cursor != nil {
+		after, err := keyset.Decode(*pagination.Cursor)
+		if err != nil {
+			return err
+		}
+
+		pageQuery = pageQuery.Scopes(keyset.After(after))
+	} else {
+		pageQuery = pageQuery.Offset(pagination.GetOffset())
+	}
+
+	var countQueryErr, listQueryErr error
+	var wg sync.WaitGroup
+
+	if pagination.WithTotal {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			countQueryErr = utils.GetPaginationInfo(pagination, db.Instance().Scopes(filter))
+		}()
+	}
+
+	// One more row is fetched to know if there is a next page
+	var rows []T
+	listQueryErr = pageQuery.Limit(limit + 1).Find(&rows).Error
+
+	wg.Wait()
+
+	if countQueryErr != nil {
+		return countQueryErr
+	}
+	if listQueryErr != nil {
+		return listQueryErr
+	}
+
+	pagination.NextCursor = nil
+	if len(rows) > limit {
+		rows = rows[:limit]
+
+		value, id := positionOf(rows[len(rows)-1])
+		next, err := keyset.Encode(value, id)
+		if err != nil {
+			return err
+		}
+		pagination.NextCursor = &next
+	}
+
+	*dest = rows
+	return nil
+}
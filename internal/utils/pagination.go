@@ -11,6 +11,12 @@ type Pagination struct {
 	Page       int `json:"page"`
 	Limit      int `json:"limit"`
 	TotalPages int `json:"total_pages"`
+
+	// Whether "Total" and "TotalPages" should be counted
+	WithTotal bool `json:"-"`
+	// Position to continue from, "Page" is ignored when set
+	Cursor     *string `json:"-"`
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
 func (p *Pagination) GetOffset() int {
@@ -0,0 +1,47 @@
+// Package signature signs values with an HMAC, to detect tampering with values given to clients
+package signature
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"sync"
+)
+
+// Only used for HMACs, JWTs are signed with the keys of the keyring
+var (
+	signingKey     []byte
+	signingKeyOnce sync.Once
+)
+
+// Gets the key configured by the SIGNING_KEY env var, loaded on first use.
+// Panics when it is not set, since values signed with an empty key could be forged by anyone.
+func key() []byte {
+	signingKeyOnce.Do(func() {
+		signingKey = []byte(os.Getenv("SIGNING_KEY"))
+		if len(signingKey) == 0 {
+			panic(errors.New("SIGNING_KEY is not set"))
+		}
+	})
+
+	return signingKey
+}
+
+// Loads the key at startup, so that a missing key stops the API before it serves any request
+func MustLoadKey() {
+	key()
+}
+
+// Hex encoded HMAC of the value
+func Sign(value string) string {
+	h := hmac.New(sha256.New, key())
+	h.Write([]byte(value))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Whether "signature" is the HMAC of the value, compared in constant time
+func Verify(value, signature string) bool {
+	return hmac.Equal([]byte(Sign(value)), []byte(signature))
+}
@@ -0,0 +1,35 @@
+package signature
+
+import (
+	"sync"
+	"testing"
+)
+
+func setKey(t *testing.T, value string) {
+	t.Setenv("SIGNING_KEY", value)
+	signingKey = nil
+	signingKeyOnce = sync.Once{}
+}
+
+func TestSignVerify(t *testing.T) {
+	setKey(t, "test key")
+
+	sig := Sign("value")
+	if !Verify("value", sig) {
+		t.Errorf("Verify() of the signature = false, want true")
+	}
+	if Verify("other value", sig) {
+		t.Errorf("Verify() of another value = true, want false")
+	}
+}
+
+func TestMissingKey(t *testing.T) {
+	setKey(t, "")
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustLoadKey() without SIGNING_KEY did not panic")
+		}
+	}()
+	MustLoadKey()
+}
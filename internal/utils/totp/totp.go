@@ -0,0 +1,108 @@
+// Time-based one-time passwords, as defined by RFC 6238,
+// with the parameters supported by common authenticator apps: SHA-1, 6 digits and 30 second steps.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	Digits     = 6
+	StepPeriod = time.Second * 30
+	// Number of steps before and after the current one in which a code is still accepted
+	Skew = 1
+
+	secretLength = 20
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Generates a new secret, base32 encoded
+func NewSecret() (string, error) {
+	bytes := make([]byte, secretLength)
+	_, err := rand.Read(bytes)
+	if err != nil {
+		return "", err
+	}
+
+	return encoding.EncodeToString(bytes), nil
+}
+
+// Gets the "otpauth" URI of the secret, to be shown as a QR code to authenticator apps
+func URI(issuer, account, secret string) string {
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+
+	q := url.Values{}
+	q.Set("secret", secret)
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(Digits))
+	q.Set("period", fmt.Sprint(int(StepPeriod.Seconds())))
+
+	return "otpauth://totp/" + label + "?" + q.Encode()
+}
+
+// Gets the time step of "t"
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(StepPeriod.Seconds())
+}
+
+// Generates the code of the base32 encoded secret for the time step
+func Code(secret string, step int64) (string, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", err
+	}
+
+	return code(key, step, Digits), nil
+}
+
+func code(key []byte, step int64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+
+	h := hmac.New(sha1.New, key)
+	h.Write(msg[:])
+	sum := h.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range digits {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
+
+// Validates the code against the base32 encoded secret at "t", allowing for some clock skew.
+// Returns the time step the code matched, so that callers can reject a code used twice.
+func Validate(secret, code string, t time.Time) (step int64, ok bool) {
+	if len(code) != Digits {
+		return 0, false
+	}
+
+	cur := Step(t)
+	for s := cur - Skew; s <= cur+Skew; s++ {
+		expected, err := Code(secret, s)
+		if err != nil {
+			return 0, false
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return s, true
+		}
+	}
+
+	return 0, false
+}
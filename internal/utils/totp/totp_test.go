@@ -0,0 +1,51 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// Test vectors of RFC 6238, appendix B, with the SHA-1 seed
+func TestCodeRFC6238(t *testing.T) {
+	key := []byte("12345678901234567890")
+
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+
+	for _, tt := range tests {
+		got := code(key, Step(time.Unix(tt.unix, 0)), 8)
+		if got != tt.want {
+			t.Errorf("code(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret := encoding.EncodeToString([]byte("12345678901234567890"))
+	now := time.Unix(1111111111, 0)
+
+	code, err := Code(secret, Step(now))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if step, ok := Validate(secret, code, now.Add(StepPeriod)); !ok || step != Step(now) {
+		t.Errorf("Validate() = %d, %t, want the step of the code", step, ok)
+	}
+	if _, ok := Validate(secret, code, now.Add(StepPeriod*3)); ok {
+		t.Errorf("Validate() accepted a code outside of the skew")
+	}
+	if _, ok := Validate(strings.ToLower(secret), "000000", now); ok && code != "000000" {
+		t.Errorf("Validate() accepted a wrong code")
+	}
+}
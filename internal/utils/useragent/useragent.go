@@ -0,0 +1,62 @@
+package useragent
+
+import "strings"
+
+const unknownDevice = "Unknown device"
+
+// Patterns are checked in order, the first one found in the user agent is used
+var (
+	browserPatterns = []struct{ pattern, name string }{
+		{"Edg/", "Edge"},
+		{"EdgiOS/", "Edge"},
+		{"OPR/", "Opera"},
+		{"SamsungBrowser/", "Samsung Internet"},
+		{"Firefox/", "Firefox"},
+		{"FxiOS/", "Firefox"},
+		{"CriOS/", "Chrome"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+	}
+	osPatterns = []struct{ pattern, name string }{
+		{"Windows", "Windows"},
+		{"Android", "Android"},
+		{"iPhone", "iOS"},
+		{"iPad", "iPadOS"},
+		{"Mac OS X", "macOS"},
+		{"CrOS", "ChromeOS"},
+		{"Linux", "Linux"},
+	}
+)
+
+// Gets a readable name of the device from its user agent, such as "Chrome on Windows".
+// Clients which are not browsers are named after their product, such as "okhttp".
+func DeviceName(userAgent string) string {
+	browser := findPattern(userAgent, browserPatterns)
+	os := findPattern(userAgent, osPatterns)
+
+	switch {
+	case browser != "" && os != "":
+		return browser + " on " + os
+	case browser != "":
+		return browser
+	case os != "":
+		return os
+	}
+
+	product, _, _ := strings.Cut(strings.TrimSpace(userAgent), "/")
+	if product == "" {
+		return unknownDevice
+	}
+
+	return product
+}
+
+func findPattern(userAgent string, patterns []struct{ pattern, name string }) string {
+	for _, p := range patterns {
+		if strings.Contains(userAgent, p.pattern) {
+			return p.name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,39 @@
+package useragent
+
+import "testing"
+
+func TestDeviceName(t *testing.T) {
+	tests := []struct {
+		userAgent string
+		want      string
+	}{
+		{
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			want:      "Chrome on Windows",
+		},
+		{
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.0.0",
+			want:      "Edge on Windows",
+		},
+		{
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1",
+			want:      "Safari on iOS",
+		},
+		{
+			userAgent: "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36",
+			want:      "Chrome on Android",
+		},
+		{
+			userAgent: "Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0",
+			want:      "Firefox on Linux",
+		},
+		{userAgent: "okhttp/4.12.0", want: "okhttp"},
+		{userAgent: "", want: "Unknown device"},
+	}
+
+	for _, tt := range tests {
+		if got := DeviceName(tt.userAgent); got != tt.want {
+			t.Errorf("DeviceName(%q) = %q, want %q", tt.userAgent, got, tt.want)
+		}
+	}
+}
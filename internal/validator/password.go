@@ -1,33 +1,23 @@
 package validator
 
 import (
-	"fmt"
+	"study-planner-api/internal/validator/passwordpolicy"
 
 	goValidator "github.com/go-playground/validator/v10"
 )
 
-var (
-	MinLength = 6
-	MaxLength = 30
-)
-
+// Validates a password against the password policy, without knowing about its user,
+// prefer passwordpolicy.Policy.Check with the email of the user when it is known
 func PasswordValidator(fl goValidator.FieldLevel) bool {
 	password, ok := fl.Field().Interface().(string)
 	if !ok {
 		return false
 	}
 
-	for _, c := range password {
-		// ! -> 33, ~ -> 126
-		if c < 33 || c > 126 {
-			return false
-		}
-	}
-
-	return true
+	return passwordpolicy.Get().Check(password) == nil
 }
 
 func RegisterPasswordValidator(v *goValidator.Validate) {
 	v.RegisterValidation("password", PasswordValidator)
-	v.RegisterAlias("default-password", fmt.Sprintf("password,min=%d,max=%d", MinLength, MaxLength))
+	v.RegisterAlias("default-password", "password")
 }
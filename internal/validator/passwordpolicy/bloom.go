@@ -0,0 +1,137 @@
+package passwordpolicy
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"os"
+)
+
+var (
+	ErrInvalidBloomFilter = errors.New("invalid bloom filter")
+
+	bloomMagic = [4]byte{'P', 'W', 'B', 'F'}
+)
+
+// Set of SHA-1 digests of passwords, which may report passwords it does not contain but never misses one.
+// Digests are used so that the filter can be built from hash lists of breached passwords.
+type BloomFilter struct {
+	bits   []uint64
+	size   uint64
+	hashes uint32
+}
+
+// Creates a filter sized for "capacity" passwords with a rate of false positives of "falsePositiveRate"
+func NewBloomFilter(capacity int, falsePositiveRate float64) *BloomFilter {
+	n := math.Max(float64(capacity), 1)
+	size := uint64(math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	hashes := uint32(math.Max(1, math.Round(float64(size)/n*math.Ln2)))
+
+	return &BloomFilter{
+		bits:   make([]uint64, (size+63)/64),
+		size:   size,
+		hashes: hashes,
+	}
+}
+
+// Positions of the bits of a digest, by double hashing
+func (f *BloomFilter) positions(digest [sha1.Size]byte, yield func(uint64) bool) {
+	h1 := binary.BigEndian.Uint64(digest[0:8])
+	h2 := binary.BigEndian.Uint64(digest[8:16]) | 1
+	for i := uint64(0); i < uint64(f.hashes); i++ {
+		if !yield((h1 + i*h2) % f.size) {
+			return
+		}
+	}
+}
+
+func (f *BloomFilter) AddDigest(digest [sha1.Size]byte) {
+	f.positions(digest, func(pos uint64) bool {
+		f.bits[pos/64] |= 1 << (pos % 64)
+		return true
+	})
+}
+
+func (f *BloomFilter) Add(password string) {
+	f.AddDigest(sha1.Sum([]byte(password)))
+}
+
+func (f *BloomFilter) Contains(password string) bool {
+	contains := true
+	f.positions(sha1.Sum([]byte(password)), func(pos uint64) bool {
+		contains = f.bits[pos/64]&(1<<(pos%64)) != 0
+		return contains
+	})
+
+	return contains
+}
+
+// Writes the filter as its magic, number of hashes, size in bits, then the bits, all big-endian
+func (f *BloomFilter) WriteTo(w io.Writer) (int64, error) {
+	bw := bufio.NewWriter(w)
+
+	header := make([]byte, 0, 16)
+	header = append(header, bloomMagic[:]...)
+	header = binary.BigEndian.AppendUint32(header, f.hashes)
+	header = binary.BigEndian.AppendUint64(header, f.size)
+	written, err := bw.Write(header)
+	if err != nil {
+		return int64(written), err
+	}
+
+	word := make([]byte, 8)
+	for _, bits := range f.bits {
+		binary.BigEndian.PutUint64(word, bits)
+		n, err := bw.Write(word)
+		written += n
+		if err != nil {
+			return int64(written), err
+		}
+	}
+
+	return int64(written), bw.Flush()
+}
+
+func ReadBloomFilter(r io.Reader) (*BloomFilter, error) {
+	br := bufio.NewReader(r)
+
+	header := make([]byte, 16)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, errors.Join(ErrInvalidBloomFilter, err)
+	}
+	if [4]byte(header[0:4]) != bloomMagic {
+		return nil, ErrInvalidBloomFilter
+	}
+
+	f := &BloomFilter{
+		hashes: binary.BigEndian.Uint32(header[4:8]),
+		size:   binary.BigEndian.Uint64(header[8:16]),
+	}
+	if f.hashes == 0 || f.size == 0 {
+		return nil, ErrInvalidBloomFilter
+	}
+
+	f.bits = make([]uint64, (f.size+63)/64)
+	word := make([]byte, 8)
+	for i := range f.bits {
+		if _, err := io.ReadFull(br, word); err != nil {
+			return nil, errors.Join(ErrInvalidBloomFilter, err)
+		}
+		f.bits[i] = binary.BigEndian.Uint64(word)
+	}
+
+	return f, nil
+}
+
+func LoadBloomFilter(path string) (*BloomFilter, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return ReadBloomFilter(file)
+}
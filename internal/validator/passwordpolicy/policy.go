@@ -0,0 +1,159 @@
+// Rules that passwords must follow, applied whenever a password is set.
+package passwordpolicy
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Limit of bcrypt, which refuses longer passwords
+const maxBytes = 72
+
+type Violation string
+
+const (
+	TooShort      Violation = "too_short"
+	TooLong       Violation = "too_long"
+	InvalidChars  Violation = "invalid_characters"
+	TooFewClasses Violation = "too_few_character_classes"
+	TooWeak       Violation = "too_weak"
+	Breached      Violation = "breached"
+)
+
+// Error of a password which does not follow the policy
+type Error struct {
+	Violations []Violation
+}
+
+func (e *Error) Error() string {
+	violations := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		violations[i] = string(v)
+	}
+
+	return "password does not follow the policy: " + strings.Join(violations, ", ")
+}
+
+type Policy struct {
+	// Length in characters
+	MinLength int
+	// Length in bytes, at most 72 since bcrypt only hashes that much
+	MaxLength int
+	// How many of the classes lowercase, uppercase, digit and symbol must be used
+	MinClasses int
+	// Minimum score of EstimateStrength, from 0 to 4
+	MinStrength int
+	// Known breached passwords, not checked when nil
+	Breached *BloomFilter
+}
+
+var Default = Policy{
+	MinLength:   8,
+	MaxLength:   maxBytes,
+	MinClasses:  1,
+	MinStrength: 2,
+}
+
+// Checks the password against the policy, with every violation in an *Error.
+// "userInputs" are things the user is known by, like their email, which make a password weak.
+func (p Policy) Check(password string, userInputs ...string) error {
+	var violations []Violation
+
+	if utf8.RuneCountInString(password) < p.MinLength {
+		violations = append(violations, TooShort)
+	}
+	if len(password) > min(p.MaxLength, maxBytes) {
+		violations = append(violations, TooLong)
+	}
+	if !utf8.ValidString(password) || strings.ContainsFunc(password, unicode.IsControl) {
+		violations = append(violations, InvalidChars)
+	}
+	if countClasses(password) < p.MinClasses {
+		violations = append(violations, TooFewClasses)
+	}
+	if p.MinStrength > 0 && EstimateStrength(password, userInputs...).Score < p.MinStrength {
+		violations = append(violations, TooWeak)
+	}
+	if p.Breached != nil && p.Breached.Contains(password) {
+		violations = append(violations, Breached)
+	}
+
+	if len(violations) > 0 {
+		return &Error{Violations: violations}
+	}
+
+	return nil
+}
+
+func countClasses(password string) int {
+	var lower, upper, digit, symbol bool
+	for _, c := range password {
+		switch {
+		case unicode.IsLower(c):
+			lower = true
+		case unicode.IsUpper(c):
+			upper = true
+		case unicode.IsDigit(c):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	count := 0
+	for _, used := range []bool{lower, upper, digit, symbol} {
+		if used {
+			count++
+		}
+	}
+
+	return count
+}
+
+var (
+	policy     Policy
+	policyOnce sync.Once
+)
+
+func getIntEnv(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value < 0 {
+		return fallback
+	}
+
+	return value
+}
+
+// Gets the policy configured by env vars, loaded on first use.
+// The breached passwords are read from the bloom filter at PASSWORD_BREACHED_FILTER, see tools/genbloom.
+func Get() Policy {
+	policyOnce.Do(func() {
+		policy = Policy{
+			MinLength:   getIntEnv("PASSWORD_MIN_LENGTH", Default.MinLength),
+			MaxLength:   getIntEnv("PASSWORD_MAX_LENGTH", Default.MaxLength),
+			MinClasses:  getIntEnv("PASSWORD_MIN_CLASSES", Default.MinClasses),
+			MinStrength: getIntEnv("PASSWORD_MIN_STRENGTH", Default.MinStrength),
+		}
+
+		path := os.Getenv("PASSWORD_BREACHED_FILTER")
+		if path == "" {
+			return
+		}
+
+		filter, err := LoadBloomFilter(path)
+		if err != nil {
+			// Better to accept breached passwords than to refuse every password
+			log.Error().Err(err).Str("path", path).Msg("failed to load breached password filter")
+			return
+		}
+		policy.Breached = filter
+	})
+
+	return policy
+}
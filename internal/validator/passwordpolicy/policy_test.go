@@ -0,0 +1,115 @@
+package passwordpolicy
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestEstimateStrength(t *testing.T) {
+	tests := []struct {
+		password   string
+		userInputs []string
+		maxScore   int
+		minScore   int
+	}{
+		{password: "password", maxScore: 0},
+		{password: "P@ssw0rd", maxScore: 0},
+		{password: "drowssap", maxScore: 0},
+		{password: "qwertyuiop", maxScore: 0},
+		{password: "abcdefgh", maxScore: 0},
+		{password: "aaaaaaaaaaaa", maxScore: 0},
+		{password: "01/02/1990", maxScore: 1},
+		{password: "johnsmith", userInputs: []string{"john.smith@example.com"}, maxScore: 1},
+		{password: "x7#Kq9!vLm2$", minScore: 4, maxScore: 4},
+		{password: "correct horse battery staple", minScore: 4, maxScore: 4},
+	}
+
+	for _, tt := range tests {
+		strength := EstimateStrength(tt.password, tt.userInputs...)
+		if strength.Score < tt.minScore || strength.Score > tt.maxScore {
+			t.Errorf("EstimateStrength(%q) = %+v, want score in [%d, %d]",
+				tt.password, strength, tt.minScore, tt.maxScore)
+		}
+	}
+}
+
+func TestEstimateStrengthOfLongPassword(t *testing.T) {
+	// Repeats are estimated recursively, which must not blow up
+	for _, password := range []string{strings.Repeat("a", 72), strings.Repeat("abc", 24)} {
+		if strength := EstimateStrength(password); strength.Score > 1 {
+			t.Errorf("EstimateStrength(%q) = %+v, want a weak password", password, strength)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	breached := NewBloomFilter(10, 0.001)
+	breached.Add("Ya8#kLq2!mWz")
+
+	policy := Policy{
+		MinLength:   8,
+		MaxLength:   72,
+		MinClasses:  3,
+		MinStrength: 3,
+		Breached:    breached,
+	}
+
+	tests := []struct {
+		password string
+		want     []Violation
+	}{
+		{password: "R7u!q2Xw#p", want: nil},
+		{password: "a1!B", want: []Violation{TooShort, TooWeak}},
+		{password: strings.Repeat("R7u!q2Xw#p", 8), want: []Violation{TooLong}},
+		{password: "R7u!q2Xw\n#p", want: []Violation{InvalidChars}},
+		{password: "r7uzq2xwkp", want: []Violation{TooFewClasses}},
+		{password: "Password1!", want: []Violation{TooWeak}},
+		{password: "Ya8#kLq2!mWz", want: []Violation{Breached}},
+	}
+
+	for _, tt := range tests {
+		err := policy.Check(tt.password)
+		if tt.want == nil {
+			if err != nil {
+				t.Errorf("Check(%q) = %v, want nil", tt.password, err)
+			}
+			continue
+		}
+
+		var policyErr *Error
+		if !errors.As(err, &policyErr) || !slices.Equal(policyErr.Violations, tt.want) {
+			t.Errorf("Check(%q) = %v, want violations %v", tt.password, err, tt.want)
+		}
+	}
+}
+
+func TestBloomFilterRoundTrip(t *testing.T) {
+	filter := NewBloomFilter(1000, 0.001)
+	for i := range 1000 {
+		filter.Add("breached" + strings.Repeat("!", i%7) + string(rune('a'+i%26)) + string(rune('0'+i%10)))
+	}
+	filter.Add("hunter2")
+
+	var buf bytes.Buffer
+	if _, err := filter.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := ReadBloomFilter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.Contains("hunter2") {
+		t.Error("loaded filter does not contain an added password")
+	}
+	if loaded.Contains("not a breached password") {
+		t.Error("loaded filter contains a password never added")
+	}
+
+	if _, err := ReadBloomFilter(strings.NewReader("not a filter at all")); !errors.Is(err, ErrInvalidBloomFilter) {
+		t.Errorf("ReadBloomFilter() of garbage = %v, want ErrInvalidBloomFilter", err)
+	}
+}
@@ -0,0 +1,547 @@
+package passwordpolicy
+
+import (
+	_ "embed"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Estimated strength of a password, after zxcvbn:
+// the password is split into the patterns it is most easily guessed with,
+// like common passwords, keyboard walks, sequences, repeats and dates, the rest being brute-forced.
+type Strength struct {
+	// Guesses needed to find the password
+	Guesses float64
+	// From 0, guessable in a few tries, to 4, safe even against offline attacks
+	Score int
+}
+
+// Guesses of patterns at least, so that a password is not split into many cheap ones
+const (
+	minGuessesSingleChar  = 10
+	minGuessesMultiChar   = 50
+	bruteforceCardinality = 10
+	minYearSpace          = 20
+	// Growth of the guesses for every additional match, so that a few patterns are not outweighed by many
+	minGuessesBeforeGrowingSequence = 10000
+)
+
+//go:embed common-passwords.txt
+var commonPasswordsText string
+
+// Rank of each common password, the most common first
+var commonPasswords = rankWords(strings.Fields(commonPasswordsText))
+
+func rankWords(words []string) map[string]int {
+	ranks := make(map[string]int, len(words))
+	for i, word := range words {
+		if _, ok := ranks[word]; !ok {
+			ranks[word] = i + 1
+		}
+	}
+
+	return ranks
+}
+
+// Part of the password from i to j inclusive, in runes, guessable in that many guesses
+type match struct {
+	i, j    int
+	guesses float64
+}
+
+type estimator struct {
+	userWords map[string]int
+	// Guesses of the parts of the password already estimated, repeated parts being estimated on their own
+	memo map[string]float64
+}
+
+func EstimateStrength(password string, userInputs ...string) Strength {
+	e := estimator{
+		userWords: userInputRanks(userInputs),
+		memo:      make(map[string]float64),
+	}
+	guesses := e.estimateGuesses([]rune(password))
+
+	score := 4
+	for i, threshold := range []float64{1e3, 1e6, 1e8, 1e10} {
+		if guesses < threshold+5 {
+			score = i
+			break
+		}
+	}
+
+	return Strength{Guesses: guesses, Score: score}
+}
+
+// Ranks the words of the user inputs, an email giving the words of its local part and domain
+func userInputRanks(userInputs []string) map[string]int {
+	var words []string
+	for _, input := range userInputs {
+		input = strings.ToLower(input)
+		words = append(words, input)
+		words = append(words, strings.FieldsFunc(input, func(c rune) bool {
+			return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+		})...)
+	}
+
+	return rankWords(words)
+}
+
+// Finds the sequence of matches covering the password with the fewest guesses
+func (e *estimator) estimateGuesses(password []rune) float64 {
+	n := len(password)
+	if n == 0 {
+		return 1
+	}
+	if guesses, ok := e.memo[string(password)]; ok {
+		return guesses
+	}
+
+	matchesEndingAt := make([][]match, n)
+	for _, m := range e.findMatches(password) {
+		matchesEndingAt[m.j] = append(matchesEndingAt[m.j], m)
+	}
+
+	// best[j][k] is the fewest guesses of the product of k matches covering password[:j]
+	best := make([][]float64, n+1)
+	for j := range best {
+		best[j] = make([]float64, n+1)
+		for k := range best[j] {
+			best[j][k] = math.Inf(1)
+		}
+	}
+	best[0][0] = 1
+
+	for j := 1; j <= n; j++ {
+		candidates := matchesEndingAt[j-1]
+		for i := 0; i < j; i++ {
+			candidates = append(candidates, match{i: i, j: j - 1, guesses: bruteforceGuesses(j - i)})
+		}
+
+		for _, m := range candidates {
+			guesses := math.Max(m.guesses, minGuesses(m.j-m.i+1))
+			for k := 0; k < m.i+1; k++ {
+				if product := best[m.i][k] * guesses; product < best[j][k+1] {
+					best[j][k+1] = product
+				}
+			}
+		}
+	}
+
+	// Every additional match is another way to combine them
+	guesses := math.Inf(1)
+	factorial := 1.0
+	for k := 1; k <= n; k++ {
+		factorial *= float64(k)
+		total := factorial*best[n][k] + math.Pow(minGuessesBeforeGrowingSequence, float64(k-1))
+		guesses = math.Min(guesses, total)
+	}
+
+	e.memo[string(password)] = guesses
+	return guesses
+}
+
+func bruteforceGuesses(length int) float64 {
+	return math.Pow(bruteforceCardinality, float64(length))
+}
+
+func minGuesses(length int) float64 {
+	if length == 1 {
+		return minGuessesSingleChar
+	}
+	return minGuessesMultiChar
+}
+
+func (e *estimator) findMatches(password []rune) []match {
+	var matches []match
+	matches = append(matches, dictionaryMatches(password, commonPasswords)...)
+	matches = append(matches, dictionaryMatches(password, e.userWords)...)
+	matches = append(matches, sequenceMatches(password)...)
+	matches = append(matches, e.repeatMatches(password)...)
+	matches = append(matches, keyboardMatches(password)...)
+	matches = append(matches, dateMatches(password)...)
+
+	return matches
+}
+
+// Substitutions of l33t speak, a second one being tried for ambiguous characters
+var (
+	leetSubstitutions = map[rune]rune{
+		'4': 'a', '@': 'a', '8': 'b', '(': 'c', '{': 'c', '3': 'e', '6': 'g', '9': 'g',
+		'1': 'i', '!': 'i', '|': 'i', '0': 'o', '$': 's', '5': 's', '7': 't', '+': 't', '%': 'x', '2': 'z',
+	}
+	leetAlternatives = map[rune]rune{
+		'1': 'l', '|': 'l', '7': 'l', '9': 'q',
+	}
+)
+
+func unleet(word []rune, substitutions map[rune]rune, fallback map[rune]rune) (string, bool) {
+	var b strings.Builder
+	substituted := false
+	for _, c := range word {
+		if s, ok := substitutions[c]; ok {
+			c = s
+			substituted = true
+		} else if s, ok := fallback[c]; ok {
+			c = s
+			substituted = true
+		}
+		b.WriteRune(c)
+	}
+
+	return b.String(), substituted
+}
+
+func reverse(word []rune) []rune {
+	reversed := make([]rune, len(word))
+	for i, c := range word {
+		reversed[len(word)-1-i] = c
+	}
+
+	return reversed
+}
+
+// Words of the dictionary, possibly capitalized, reversed or in l33t speak
+func dictionaryMatches(password []rune, ranks map[string]int) []match {
+	if len(ranks) == 0 {
+		return nil
+	}
+
+	// Lowered by rune, since lowering a string may change its number of runes
+	lower := make([]rune, len(password))
+	for i, c := range password {
+		lower[i] = unicode.ToLower(c)
+	}
+
+	var matches []match
+	for i := range lower {
+		for j := i + 2; j < len(lower); j++ {
+			word := lower[i : j+1]
+			original := password[i : j+1]
+
+			rank := 0
+			variations := uppercaseVariations(original)
+			if r, ok := ranks[string(word)]; ok {
+				rank = r
+			} else if r, ok := ranks[string(reverse(word))]; ok {
+				rank = r
+				variations *= 2
+			} else {
+				for _, substitutions := range []map[rune]rune{leetSubstitutions, leetAlternatives} {
+					unleeted, substituted := unleet(word, substitutions, leetSubstitutions)
+					if r, ok := ranks[unleeted]; substituted && ok {
+						rank = r
+						variations *= 2
+						break
+					}
+				}
+			}
+
+			if rank > 0 {
+				matches = append(matches, match{i: i, j: j, guesses: float64(rank) * variations})
+			}
+		}
+	}
+
+	return matches
+}
+
+func uppercaseVariations(word []rune) float64 {
+	upper, lower := 0, 0
+	for _, c := range word {
+		switch {
+		case unicode.IsUpper(c):
+			upper++
+		case unicode.IsLower(c):
+			lower++
+		}
+	}
+
+	switch {
+	case upper == 0:
+		return 1
+	// Capitalized or all uppercase
+	case lower == 0 || (upper == 1 && unicode.IsUpper(word[0])):
+		return 2
+	default:
+		return math.Pow(2, float64(min(upper, lower)))
+	}
+}
+
+// Runs of characters with a constant step, like "abcd", "1357" or "zyx"
+func sequenceMatches(password []rune) []match {
+	var matches []match
+
+	addMatch := func(i, j int, delta int) {
+		if j-i < 2 || delta == 0 || delta > 5 || delta < -5 {
+			return
+		}
+
+		first := password[i]
+		var base float64
+		switch {
+		case strings.ContainsRune("aAzZ019", first):
+			base = 4
+		case unicode.IsDigit(first):
+			base = 10
+		default:
+			base = 26
+		}
+		if delta < 0 {
+			base *= 2
+		}
+
+		matches = append(matches, match{i: i, j: j, guesses: base * float64(j-i+1)})
+	}
+
+	i := 0
+	for i < len(password)-1 {
+		delta := int(password[i+1]) - int(password[i])
+		j := i + 1
+		for j+1 < len(password) && int(password[j+1])-int(password[j]) == delta {
+			j++
+		}
+		addMatch(i, j, delta)
+		i = j
+	}
+
+	return matches
+}
+
+// Repetitions of a part, like "aaa" or "abcabc", as many times the guesses of the part
+func (e *estimator) repeatMatches(password []rune) []match {
+	var matches []match
+	n := len(password)
+	for i := 0; i < n; i++ {
+		for size := 1; i+2*size <= n; size++ {
+			unit := password[i : i+size]
+
+			count := 1
+			for i+(count+1)*size <= n && string(password[i+count*size:i+(count+1)*size]) == string(unit) {
+				count++
+			}
+			if count < 2 || count*size < 3 {
+				continue
+			}
+
+			guesses := e.estimateGuesses(unit) * float64(count)
+			matches = append(matches, match{i: i, j: i + count*size - 1, guesses: guesses})
+		}
+	}
+
+	return matches
+}
+
+// Rows of a QWERTY keyboard, each shifted half a key to the right of the one above
+var keyboardRows = []string{
+	"1234567890-=",
+	"qwertyuiop[]\\",
+	"asdfghjkl;'",
+	"zxcvbnm,./",
+}
+
+const (
+	keyboardShifted = "~!@#$%^&*()_+QWERTYUIOP{}|ASDFGHJKL:\"ZXCVBNM<>?"
+	keyboardPlain   = "`1234567890-=qwertyuiop[]\\asdfghjkl;'zxcvbnm,./"
+	// Keys of the keyboard, and neighbors of a key on average
+	keyboardKeys   = 47
+	keyboardDegree = 4.6
+)
+
+type keyPosition struct {
+	row, col int
+}
+
+var keyboardPositions = func() map[rune]keyPosition {
+	positions := make(map[rune]keyPosition)
+	for row, keys := range keyboardRows {
+		for col, key := range keys {
+			positions[key] = keyPosition{row: row, col: col}
+		}
+	}
+
+	return positions
+}()
+
+func unshift(c rune) (rune, bool) {
+	if i := strings.IndexRune(keyboardShifted, c); i >= 0 {
+		return []rune(keyboardPlain)[i], true
+	}
+	return c, false
+}
+
+// Direction from one key to an adjacent one, or -1 when they are not adjacent
+func keyDirection(from, to rune) int {
+	a, okA := keyboardPositions[from]
+	b, okB := keyboardPositions[to]
+	if !okA || !okB {
+		return -1
+	}
+
+	neighbors := []keyPosition{
+		{0, -1}, {0, 1},
+		{-1, 0}, {-1, 1},
+		{1, -1}, {1, 0},
+	}
+	for direction, offset := range neighbors {
+		if b.row-a.row == offset.row && b.col-a.col == offset.col {
+			return direction
+		}
+	}
+
+	return -1
+}
+
+// Walks over adjacent keys, like "qwerty" or "zaq1", more guesses for every turn and shifted key
+func keyboardMatches(password []rune) []match {
+	plain := make([]rune, len(password))
+	shifted := make([]bool, len(password))
+	for i, c := range password {
+		plain[i], shifted[i] = unshift(c)
+	}
+
+	var matches []match
+	i := 0
+	for i < len(plain)-1 {
+		j := i
+		turns := 0
+		lastDirection := -1
+		for j+1 < len(plain) {
+			direction := keyDirection(plain[j], plain[j+1])
+			if direction < 0 {
+				break
+			}
+			if direction != lastDirection {
+				turns++
+				lastDirection = direction
+			}
+			j++
+		}
+
+		if j-i >= 2 {
+			length := float64(j - i + 1)
+			guesses := keyboardKeys * length * math.Pow(keyboardDegree, float64(turns))
+
+			shiftedCount := 0
+			for _, s := range shifted[i : j+1] {
+				if s {
+					shiftedCount++
+				}
+			}
+			if shiftedCount > 0 {
+				guesses *= math.Pow(2, math.Min(float64(shiftedCount), length-float64(shiftedCount)+1))
+			}
+
+			matches = append(matches, match{i: i, j: j, guesses: guesses})
+			i = j
+		} else {
+			i++
+		}
+	}
+
+	return matches
+}
+
+var (
+	yearPattern = regexp.MustCompile(`(19|20)\d\d`)
+	datePattern = regexp.MustCompile(`^\d{1,4}[-/._ ]?\d{1,2}[-/._ ]?\d{1,4}$`)
+)
+
+func yearSpace(year int) float64 {
+	return math.Max(math.Abs(float64(year-time.Now().Year())), minYearSpace)
+}
+
+// Years, and dates of a day, month and year in any order
+func dateMatches(password []rune) []match {
+	var matches []match
+
+	// Patterns are matched on ASCII digits and separators, so byte offsets are rune offsets within them
+	text := string(password)
+	runeOffset := func(byteOffset int) int {
+		return len([]rune(text[:byteOffset]))
+	}
+
+	for i := 0; i < len(text); i++ {
+		loc := yearPattern.FindStringIndex(text[i:])
+		if loc == nil {
+			break
+		}
+		start, end := i+loc[0], i+loc[1]
+		year, _ := strconv.Atoi(text[start:end])
+		matches = append(matches, match{i: runeOffset(start), j: runeOffset(end) - 1, guesses: yearSpace(year)})
+		i = start
+	}
+
+	for start := 0; start < len(text); start++ {
+		for end := start + 4; end <= len(text) && end <= start+10; end++ {
+			candidate := text[start:end]
+			if !datePattern.MatchString(candidate) {
+				continue
+			}
+
+			year, ok := parseDate(candidate)
+			if !ok {
+				continue
+			}
+
+			guesses := yearSpace(year) * 365
+			if strings.ContainsAny(candidate, "-/._ ") {
+				guesses *= 4
+			}
+			matches = append(matches, match{i: runeOffset(start), j: runeOffset(end) - 1, guesses: guesses})
+		}
+	}
+
+	return matches
+}
+
+// Gets the year of a date written with digits, optionally separated, in any common order
+func parseDate(candidate string) (int, bool) {
+	digits := strings.Map(func(c rune) rune {
+		if c >= '0' && c <= '9' {
+			return c
+		}
+		return -1
+	}, candidate)
+
+	var splits [][3]string
+	if separated := strings.FieldsFunc(candidate, func(c rune) bool { return strings.ContainsRune("-/._ ", c) }); len(separated) == 3 {
+		splits = append(splits, [3]string{separated[0], separated[1], separated[2]})
+	} else if len(separated) == 1 {
+		for a := 1; a < len(digits)-1; a++ {
+			for b := a + 1; b < len(digits); b++ {
+				splits = append(splits, [3]string{digits[:a], digits[a:b], digits[b:]})
+			}
+		}
+	}
+
+	for _, parts := range splits {
+		for _, order := range [][3]int{{0, 1, 2}, {1, 0, 2}, {2, 1, 0}, {2, 0, 1}} {
+			day, _ := strconv.Atoi(parts[order[0]])
+			month, _ := strconv.Atoi(parts[order[1]])
+			yearText := parts[order[2]]
+			year, _ := strconv.Atoi(yearText)
+
+			if len(yearText) == 2 {
+				if year > 50 {
+					year += 1900
+				} else {
+					year += 2000
+				}
+			} else if len(yearText) != 4 {
+				continue
+			}
+
+			if len(parts[order[0]]) <= 2 && len(parts[order[1]]) <= 2 &&
+				day >= 1 && day <= 31 && month >= 1 && month <= 12 && year >= 1900 && year <= 2099 {
+				return year, true
+			}
+		}
+	}
+
+	return 0, false
+}
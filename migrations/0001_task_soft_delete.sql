@@ -0,0 +1,5 @@
+-- Tasks are moved to trash instead of being deleted right away,
+-- they are purged together with their focus sessions after the retention period.
+ALTER TABLE task ADD COLUMN deleted_at DATETIME;
+
+CREATE INDEX idx_task_deleted_at ON task (deleted_at);
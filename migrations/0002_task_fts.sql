@@ -0,0 +1,27 @@
+-- Full-text search index over tasks, kept in sync with the task table by triggers.
+CREATE VIRTUAL TABLE task_fts USING fts5(
+    name,
+    description,
+    content = 'task',
+    content_rowid = 'id',
+    tokenize = 'unicode61 remove_diacritics 2'
+);
+
+INSERT INTO task_fts (task_fts) VALUES ('rebuild');
+
+CREATE TRIGGER task_fts_after_insert AFTER INSERT ON task BEGIN
+    INSERT INTO task_fts (rowid, name, description)
+    VALUES (new.id, new.name, new.description);
+END;
+
+CREATE TRIGGER task_fts_after_delete AFTER DELETE ON task BEGIN
+    INSERT INTO task_fts (task_fts, rowid, name, description)
+    VALUES ('delete', old.id, old.name, old.description);
+END;
+
+CREATE TRIGGER task_fts_after_update AFTER UPDATE OF name, description ON task BEGIN
+    INSERT INTO task_fts (task_fts, rowid, name, description)
+    VALUES ('delete', old.id, old.name, old.description);
+    INSERT INTO task_fts (rowid, name, description)
+    VALUES (new.id, new.name, new.description);
+END;
@@ -0,0 +1,18 @@
+-- Saved task filters (smart lists). Dates are stored as expressions such as "today+7d",
+-- they are resolved every time the view is executed.
+CREATE TABLE task_view (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES user (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    status TEXT,
+    exclude_status TEXT,
+    priority TEXT,
+    search TEXT,
+    start_date TEXT,
+    end_date TEXT,
+    sort_by TEXT,
+    sort_order TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, name)
+);
@@ -0,0 +1,7 @@
+-- Refresh tokens are grouped in families, a session holds the latest token of its family.
+-- Existing sessions have no family and could not be rotated anymore, so they are removed.
+DELETE FROM user_session;
+
+ALTER TABLE user_session ADD COLUMN family_id TEXT NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX idx_user_session_family_id ON user_session (family_id);
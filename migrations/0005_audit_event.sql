@@ -0,0 +1,10 @@
+-- Security relevant events of user accounts
+CREATE TABLE audit_event (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER REFERENCES user (id) ON DELETE SET NULL,
+    event_type TEXT NOT NULL,
+    details TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_audit_event_user_id_created_at ON audit_event (user_id, created_at);
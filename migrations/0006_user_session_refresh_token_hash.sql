@@ -0,0 +1,5 @@
+-- Refresh tokens are stored as HMACs instead of plaintext.
+-- The plaintext tokens cannot be kept, so every existing session is invalidated.
+DELETE FROM user_session;
+
+ALTER TABLE user_session RENAME COLUMN refresh_token TO refresh_token_hash;
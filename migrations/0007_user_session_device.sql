@@ -0,0 +1,5 @@
+-- Where each session is used from, so users can review and revoke their sessions
+ALTER TABLE user_session ADD COLUMN device_name TEXT;
+ALTER TABLE user_session ADD COLUMN user_agent TEXT;
+ALTER TABLE user_session ADD COLUMN ip_address TEXT;
+ALTER TABLE user_session ADD COLUMN last_used_at DATETIME;
@@ -0,0 +1,3 @@
+-- Access tokens carry the token version of their user,
+-- bumping it revokes every access token issued before.
+ALTER TABLE user ADD COLUMN token_version INTEGER NOT NULL DEFAULT 0;
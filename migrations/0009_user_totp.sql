@@ -0,0 +1,21 @@
+-- TOTP second factor of users, the secret is encrypted with ENCRYPTION_KEY
+CREATE TABLE user_totp (
+    user_id INTEGER PRIMARY KEY REFERENCES user (id) ON DELETE CASCADE,
+    secret TEXT NOT NULL,
+    -- Set once the user confirmed the enrollment with a code, 2FA is only enforced after
+    confirmed_at DATETIME,
+    -- Time step of the last accepted code, so that a code cannot be used twice
+    last_used_step INTEGER,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- One-time codes to log in without the authenticator, stored as HMACs
+CREATE TABLE user_recovery_code (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES user (id) ON DELETE CASCADE,
+    code_hash TEXT NOT NULL,
+    used_at DATETIME,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_user_recovery_code_user_id ON user_recovery_code (user_id);
@@ -0,0 +1,17 @@
+-- Passkeys of users, to log in with WebAuthn
+CREATE TABLE webauthn_credential (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES user (id) ON DELETE CASCADE,
+    -- Base64url encoded, chosen by the authenticator
+    credential_id TEXT NOT NULL,
+    -- Base64url encoded COSE key
+    public_key TEXT NOT NULL,
+    -- Signature counter of the authenticator, a decrease reveals a cloned authenticator
+    sign_count INTEGER NOT NULL DEFAULT 0,
+    name TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    last_used_at DATETIME
+);
+
+CREATE UNIQUE INDEX idx_webauthn_credential_credential_id ON webauthn_credential (credential_id);
+CREATE INDEX idx_webauthn_credential_user_id ON webauthn_credential (user_id);
@@ -0,0 +1,12 @@
+-- Failed logins in a row, per account (by email) and per IP address,
+-- used to delay further attempts and to lock out temporarily
+CREATE TABLE login_throttle (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    scope TEXT NOT NULL,
+    key TEXT NOT NULL,
+    failures INTEGER NOT NULL DEFAULT 0,
+    last_failed_at DATETIME,
+    blocked_until DATETIME
+);
+
+CREATE UNIQUE INDEX idx_login_throttle_scope_key ON login_throttle (scope, key);
@@ -0,0 +1,11 @@
+-- Token buckets of the rate limits, shared by every instance of the API
+CREATE TABLE rate_limit_bucket (
+    key TEXT PRIMARY KEY,
+    tokens REAL NOT NULL,
+    -- Unix time in milliseconds of the last refill
+    refilled_at INTEGER NOT NULL,
+    -- Incremented on every update, to detect concurrent updates
+    version INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_rate_limit_bucket_refilled_at ON rate_limit_bucket (refilled_at);
@@ -0,0 +1,21 @@
+-- Identities of users at external providers, replacing "user.google_id"
+CREATE TABLE external_identity (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES user (id) ON DELETE CASCADE,
+    -- Name of the provider, as configured
+    provider TEXT NOT NULL,
+    -- Identifies the user at the provider
+    subject TEXT NOT NULL,
+    -- Email given by the provider on the last login
+    email TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    last_used_at DATETIME
+);
+
+CREATE UNIQUE INDEX idx_external_identity_provider_subject ON external_identity (provider, subject);
+CREATE INDEX idx_external_identity_user_id ON external_identity (user_id);
+
+INSERT INTO external_identity (user_id, provider, subject, email)
+SELECT id, 'google', google_id, email FROM user WHERE google_id IS NOT NULL;
+
+-- "user.google_id" is no longer written, it is kept until every instance uses "external_identity"
@@ -0,0 +1,12 @@
+-- One-time codes delivered to applications by the callback of external providers, redeemed for auth tokens
+CREATE TABLE oauth_exchange_code (
+    -- HMAC of the code
+    code_hash TEXT PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES user (id) ON DELETE CASCADE,
+    -- S256 PKCE challenge of the application, empty when it gave none
+    code_challenge TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME NOT NULL
+);
+
+CREATE INDEX idx_oauth_exchange_code_expires_at ON oauth_exchange_code (expires_at);
@@ -0,0 +1,2 @@
+-- New email requested by the user, applied once confirmed with an "email_change" token sent to it
+ALTER TABLE user ADD COLUMN pending_email TEXT;
@@ -0,0 +1,4 @@
+-- When the account is erased, unless the user logs in before, set when its deletion is requested
+ALTER TABLE user ADD COLUMN deletion_scheduled_at DATETIME;
+
+CREATE INDEX idx_user_deletion_scheduled_at ON user (deletion_scheduled_at);
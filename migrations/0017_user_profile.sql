@@ -0,0 +1,12 @@
+-- Profile and preferences of the user, filled from the claims of the provider on signup when known
+ALTER TABLE user ADD COLUMN display_name TEXT;
+ALTER TABLE user ADD COLUMN avatar_url TEXT;
+-- BCP 47 language tag, e.g. "en-US"
+ALTER TABLE user ADD COLUMN locale TEXT;
+-- IANA time zone, e.g. "Europe/Paris"
+ALTER TABLE user ADD COLUMN timezone TEXT;
+-- 0 for Sunday to 6 for Saturday
+ALTER TABLE user ADD COLUMN week_start_day INTEGER NOT NULL DEFAULT 1;
+-- In seconds, used when a focus session is started without them
+ALTER TABLE user ADD COLUMN default_focus_duration INTEGER;
+ALTER TABLE user ADD COLUMN default_break_duration INTEGER;
@@ -0,0 +1,7 @@
+-- Role of the user, granted to its access tokens as security scopes: "user" or "admin".
+-- Admins are appointed in the database, e.g. UPDATE user SET role = 'admin' WHERE email = '...'
+ALTER TABLE user ADD COLUMN role TEXT NOT NULL DEFAULT 'user';
+-- When the account was deactivated by an admin, its user cannot log in until it is reactivated
+ALTER TABLE user ADD COLUMN disabled_at DATETIME;
+
+CREATE INDEX idx_user_role ON user (role);
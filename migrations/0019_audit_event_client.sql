@@ -0,0 +1,26 @@
+-- Where the event comes from, unknown for events of the system such as the erasure of accounts
+ALTER TABLE audit_event ADD COLUMN ip_address TEXT;
+ALTER TABLE audit_event ADD COLUMN user_agent TEXT;
+
+CREATE INDEX idx_audit_event_created_at ON audit_event (created_at);
+
+-- Audit events are append-only: they are never deleted,
+-- and only their personal data can be cleared, when the account of the user is erased
+CREATE TRIGGER audit_event_no_update
+BEFORE UPDATE ON audit_event
+WHEN NEW.id IS NOT OLD.id
+    OR NEW.event_type IS NOT OLD.event_type
+    OR NEW.created_at IS NOT OLD.created_at
+    OR (NEW.user_id IS NOT NULL AND NEW.user_id IS NOT OLD.user_id)
+    OR (NEW.details IS NOT NULL AND NEW.details IS NOT OLD.details)
+    OR (NEW.ip_address IS NOT NULL AND NEW.ip_address IS NOT OLD.ip_address)
+    OR (NEW.user_agent IS NOT NULL AND NEW.user_agent IS NOT OLD.user_agent)
+BEGIN
+    SELECT RAISE(ABORT, 'audit events are append-only');
+END;
+
+CREATE TRIGGER audit_event_no_delete
+BEFORE DELETE ON audit_event
+BEGIN
+    SELECT RAISE(ABORT, 'audit events are append-only');
+END;
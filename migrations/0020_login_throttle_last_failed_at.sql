@@ -0,0 +1,2 @@
+-- Failures are forgotten a day after the last one, their rows are then purged
+CREATE INDEX idx_login_throttle_last_failed_at ON login_throttle (last_failed_at);
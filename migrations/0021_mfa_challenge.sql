@@ -0,0 +1,11 @@
+-- Challenges of logins waiting for their second factor, identified by the "jti" of the challenge token.
+-- A challenge is deleted once it is completed, or after too many invalid codes.
+CREATE TABLE mfa_challenge (
+    id TEXT PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES user (id) ON DELETE CASCADE,
+    failures INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME NOT NULL
+);
+
+CREATE INDEX idx_mfa_challenge_expires_at ON mfa_challenge (expires_at);
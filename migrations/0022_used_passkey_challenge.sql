@@ -0,0 +1,9 @@
+-- Challenges of passkey ceremonies which have been answered, so that a ceremony cannot be replayed.
+-- They are purged once the tokens carrying them have expired.
+CREATE TABLE used_passkey_challenge (
+    challenge TEXT PRIMARY KEY,
+    used_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME NOT NULL
+);
+
+CREATE INDEX idx_used_passkey_challenge_expires_at ON used_passkey_challenge (expires_at);
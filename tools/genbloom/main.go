@@ -0,0 +1,107 @@
+// Builds the bloom filter of breached passwords loaded from PASSWORD_BREACHED_FILTER.
+//
+// Usage: go run ./tools/genbloom -o breached.bloom [-rate 0.001] [-capacity N] passwords.txt
+//
+// Each line of the input is either a password, or the SHA-1 of one in hex optionally followed by ":count",
+// as in the lists of Have I Been Pwned.
+package main
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"study-planner-api/internal/validator/passwordpolicy"
+)
+
+func parseLine(line string) (digest [sha1.Size]byte) {
+	hash, _, _ := strings.Cut(line, ":")
+	if len(hash) == hex.EncodedLen(sha1.Size) {
+		if _, err := hex.Decode(digest[:], []byte(hash)); err == nil {
+			return digest
+		}
+	}
+
+	return sha1.Sum([]byte(line))
+}
+
+func countLines(path string) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	count := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		count++
+	}
+
+	return count, scanner.Err()
+}
+
+func run(inputPath string, outputPath string, capacity int, rate float64) error {
+	if capacity <= 0 {
+		var err error
+		capacity, err = countLines(inputPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	input, err := os.Open(inputPath)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	filter := passwordpolicy.NewBloomFilter(capacity, rate)
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		filter.AddDigest(parseLine(line))
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	output, err := os.Create(outputPath)
+	if err != nil {
+		return err
+	}
+	defer output.Close()
+
+	_, err = filter.WriteTo(output)
+	if err != nil {
+		return err
+	}
+
+	return output.Close()
+}
+
+func main() {
+	outputPath := flag.String("o", "breached.bloom", "path of the filter to write")
+	rate := flag.Float64("rate", 0.001, "rate of false positives")
+	capacity := flag.Int("capacity", 0, "number of passwords, counted from the input when 0")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: genbloom [flags] passwords.txt")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	err := run(flag.Arg(0), *outputPath, *capacity, *rate)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}